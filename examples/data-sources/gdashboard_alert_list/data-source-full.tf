@@ -0,0 +1,19 @@
+data "gdashboard_alert_list" "critical_alerts" {
+  title       = "Critical alerts"
+  description = "The firing and pending critical alerts grouped by team"
+
+  graph {
+    view_mode      = "list"
+    group_by       = ["team"]
+    sort_order     = "importance"
+    limit          = 50
+    show_instances = true
+    label_filter   = "{severity=\"critical\"}"
+    folder_uid     = "alerts"
+
+    state_filter {
+      firing  = true
+      pending = true
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "gdashboard_alert_list" "alerts" {
+  title = "Alerts"
+}
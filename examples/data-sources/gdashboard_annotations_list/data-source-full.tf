@@ -0,0 +1,16 @@
+data "gdashboard_annotations_list" "deployments" {
+  title       = "Deployments"
+  description = "The recent deployments of the service"
+
+  graph {
+    only_this_dashboard = true
+    only_in_time_range  = true
+    tags                = ["deploy"]
+    limit               = 20
+    show_user           = true
+    show_time           = true
+    show_tags           = false
+    navigate_before     = "30m"
+    navigate_after      = "30m"
+  }
+}
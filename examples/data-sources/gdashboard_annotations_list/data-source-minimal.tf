@@ -0,0 +1,3 @@
+data "gdashboard_annotations_list" "events" {
+  title = "Events"
+}
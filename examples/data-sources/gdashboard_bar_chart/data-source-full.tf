@@ -0,0 +1,56 @@
+data "gdashboard_bar_chart" "top_handlers" {
+  title       = "Top 10 handlers"
+  description = "The busiest HTTP handlers"
+
+  legend {
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "single"
+  }
+
+  field {
+    unit = "reqps"
+
+    thresholds {
+      show_as = "line"
+
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "red"
+        value = 100
+      }
+    }
+  }
+
+  axis {
+    label     = "Requests"
+    placement = "left"
+    soft_min  = 0
+  }
+
+  graph {
+    orientation          = "horizontal"
+    x_field              = "handler"
+    group_width          = 0.7
+    bar_width            = 0.9
+    bar_radius           = 0.1
+    stacking             = "none"
+    show_values          = "always"
+    value_label_rotation = -45
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "topk(10, sum by (handler) (rate(http_requests_total[$__rate_interval])))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "gdashboard_bar_chart" "top_handlers" {
+  title = "Top 10 handlers"
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "topk(10, sum by (handler) (rate(http_requests_total[$__rate_interval])))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
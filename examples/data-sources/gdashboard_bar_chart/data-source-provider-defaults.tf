@@ -0,0 +1,48 @@
+provider "gdashboard" {
+  defaults {
+    bar_chart {
+      legend {
+        display_mode = "hidden"
+      }
+
+      graph {
+        orientation = "horizontal"
+        show_values = "always"
+      }
+    }
+  }
+}
+
+data "gdashboard_bar_chart" "top_handlers" {
+  title = "Top 10 handlers"
+
+  field {
+    unit = "reqps"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "topk(10, sum by (handler) (rate(http_requests_total[$__rate_interval])))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
+
+data "gdashboard_bar_chart" "top_pods" {
+  title = "Top 10 pods by memory"
+
+  field {
+    unit = "bytes"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "topk(10, sum by (pod) (container_memory_usage_bytes))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
@@ -0,0 +1,48 @@
+data "gdashboard_candlestick" "price" {
+  title       = "Price"
+  description = "The hourly price of the ACME stock"
+
+  legend {
+    calculations = ["last"]
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit     = "currencyUSD"
+    decimals = 2
+  }
+
+  axis {
+    label     = "Price"
+    placement = "right"
+  }
+
+  graph {
+    mode           = "both"
+    candle_style   = "candles"
+    color_strategy = "open_close"
+    up_color       = "green"
+    down_color     = "red"
+  }
+
+  fields {
+    open   = "open"
+    high   = "high"
+    low    = "low"
+    close  = "close"
+    volume = "volume"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "stock_price{symbol='ACME'}"
+      format = "table"
+    }
+  }
+}
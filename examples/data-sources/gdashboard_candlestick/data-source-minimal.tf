@@ -0,0 +1,10 @@
+data "gdashboard_candlestick" "price" {
+  title = "Price"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "stock_price{symbol='ACME'}"
+    }
+  }
+}
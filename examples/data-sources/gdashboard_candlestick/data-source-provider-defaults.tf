@@ -0,0 +1,36 @@
+provider "gdashboard" {
+  defaults {
+    candlestick {
+      field {
+        unit = "currencyUSD"
+      }
+
+      graph {
+        up_color   = "blue"
+        down_color = "orange"
+      }
+    }
+  }
+}
+
+data "gdashboard_candlestick" "acme" {
+  title = "ACME"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "stock_price{symbol='ACME'}"
+    }
+  }
+}
+
+data "gdashboard_candlestick" "initech" {
+  title = "Initech"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "stock_price{symbol='INITECH'}"
+    }
+  }
+}
@@ -0,0 +1,97 @@
+data "gdashboard_canvas" "overview" {
+  title       = "Overview"
+  description = "The traffic from the gateway to the database"
+
+  field {
+    unit = "reqps"
+
+    thresholds {
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "red"
+        value = 1000
+      }
+    }
+  }
+
+  graph {
+    inline_editing = false
+  }
+
+  element {
+    type = "server"
+    name = "Gateway"
+
+    placement {
+      top    = 20
+      left   = 20
+      width  = 80
+      height = 80
+    }
+
+    server {
+      type             = "single"
+      bulb_color_field = "Value"
+    }
+
+    connection {
+      target = "Database"
+      color  = "white"
+      width  = 2
+    }
+  }
+
+  element {
+    type = "metric_value"
+    name = "Throughput"
+
+    placement {
+      top    = 40
+      left   = 140
+      width  = 160
+      height = 40
+    }
+
+    background {
+      color_field = "Value"
+    }
+
+    border {
+      color  = "transparent"
+      radius = 8
+    }
+
+    text {
+      field = "Value"
+      color = "white"
+      size  = 20
+    }
+  }
+
+  element {
+    type = "server"
+    name = "Database"
+
+    placement {
+      top    = 20
+      left   = 340
+      width  = 80
+      height = 80
+    }
+
+    server {
+      type = "database"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum(rate(http_requests_total[$__rate_interval]))"
+      instant = true
+    }
+  }
+}
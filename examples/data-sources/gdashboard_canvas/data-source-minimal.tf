@@ -0,0 +1,19 @@
+data "gdashboard_canvas" "overview" {
+  title = "Overview"
+
+  element {
+    type = "metric_value"
+
+    text {
+      field = "Value"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum(rate(http_requests_total[$__rate_interval]))"
+      instant = true
+    }
+  }
+}
@@ -0,0 +1,53 @@
+provider "gdashboard" {
+  defaults {
+    canvas {
+      field {
+        unit = "reqps"
+      }
+
+      graph {
+        inline_editing = false
+      }
+    }
+  }
+}
+
+data "gdashboard_canvas" "gateway" {
+  title = "Gateway"
+
+  element {
+    type = "metric_value"
+
+    text {
+      field = "Value"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum(rate(http_requests_total{service='gateway'}[$__rate_interval]))"
+      instant = true
+    }
+  }
+}
+
+data "gdashboard_canvas" "backend" {
+  title = "Backend"
+
+  element {
+    type = "metric_value"
+
+    text {
+      field = "Value"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum(rate(http_requests_total{service='backend'}[$__rate_interval]))"
+      instant = true
+    }
+  }
+}
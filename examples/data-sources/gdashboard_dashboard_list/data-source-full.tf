@@ -0,0 +1,17 @@
+data "gdashboard_dashboard_list" "services" {
+  title       = "Service dashboards"
+  description = "The dashboards of the production services"
+
+  graph {
+    starred            = false
+    recently_viewed    = false
+    search             = true
+    headings           = false
+    limit              = 20
+    query              = "Service"
+    tags               = ["production"]
+    folder_uid         = "services"
+    include_variables  = true
+    include_time_range = true
+  }
+}
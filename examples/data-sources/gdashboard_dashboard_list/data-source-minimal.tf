@@ -0,0 +1,3 @@
+data "gdashboard_dashboard_list" "starred" {
+  title = "Starred dashboards"
+}
@@ -0,0 +1,14 @@
+data "gdashboard_flame_graph" "cpu" {
+  title       = "CPU profile"
+  description = "The CPU profile of the backend service"
+
+  queries {
+    pyroscope {
+      uid             = "pyroscope"
+      profile_type_id = "process_cpu:cpu:nanoseconds:cpu:nanoseconds"
+      label_selector  = "{service_name=\"backend\"}"
+      group_by        = ["pod"]
+      max_nodes       = 4096
+    }
+  }
+}
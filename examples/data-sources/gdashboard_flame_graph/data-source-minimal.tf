@@ -0,0 +1,3 @@
+data "gdashboard_flame_graph" "cpu" {
+  title = "CPU profile"
+}
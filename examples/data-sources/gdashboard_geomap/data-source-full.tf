@@ -0,0 +1,85 @@
+data "gdashboard_geomap" "requests_by_country" {
+  title       = "Requests by country"
+  description = "The number of requests grouped by the country of the client"
+
+  field {
+    unit = "short"
+
+    color {
+      mode = "continuous-GrYlRd"
+    }
+  }
+
+  view {
+    latitude  = 48.85
+    longitude = 2.35
+    zoom      = 4
+  }
+
+  controls {
+    show_zoom        = true
+    mouse_wheel_zoom = false
+    show_attribution = true
+    show_scale       = true
+    show_measure     = false
+    show_debug       = false
+  }
+
+  base_layer {
+    type        = "carto"
+    theme       = "light"
+    show_labels = true
+  }
+
+  layer {
+    type        = "markers"
+    name        = "Requests"
+    tooltip     = true
+    show_legend = true
+
+    location {
+      mode         = "lookup"
+      lookup_field = "country"
+      gazetteer    = "public/gazetteer/countries.json"
+    }
+
+    style {
+      size_field  = "Value"
+      size_min    = 2
+      size_max    = 20
+      color_field = "Value"
+      opacity     = 0.5
+      symbol      = "circle"
+    }
+  }
+
+  layer {
+    type = "heatmap"
+    name = "Density"
+
+    location {
+      mode         = "lookup"
+      lookup_field = "country"
+      gazetteer    = "public/gazetteer/countries.json"
+    }
+
+    heatmap {
+      blur         = 15
+      radius       = 5
+      weight_field = "Value"
+    }
+  }
+
+  tooltip {
+    mode = "details"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (country) (increase(http_requests_total[$__range]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
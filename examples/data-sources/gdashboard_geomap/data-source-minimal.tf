@@ -0,0 +1,22 @@
+data "gdashboard_geomap" "requests_by_location" {
+  title = "Requests by location"
+
+  layer {
+    type = "markers"
+
+    location {
+      mode            = "coords"
+      latitude_field  = "latitude"
+      longitude_field = "longitude"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (latitude, longitude) (increase(http_requests_total[$__range]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
@@ -0,0 +1,48 @@
+provider "gdashboard" {
+  defaults {
+    geomap {
+      view {
+        fit_to_data = true
+      }
+
+      base_layer {
+        type  = "carto"
+        theme = "dark"
+      }
+    }
+  }
+}
+
+data "gdashboard_geomap" "requests_by_location" {
+  title = "Requests by location"
+
+  layer {
+    type = "markers"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (latitude, longitude) (increase(http_requests_total[$__range]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
+
+data "gdashboard_geomap" "errors_by_location" {
+  title = "Errors by location"
+
+  layer {
+    type = "heatmap"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (latitude, longitude) (increase(http_requests_total{status=~'5..'}[$__range]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
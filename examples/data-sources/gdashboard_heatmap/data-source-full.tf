@@ -0,0 +1,74 @@
+data "gdashboard_heatmap" "latency" {
+  title = "Request latency"
+
+  legend {
+    show = true
+  }
+
+  tooltip {
+    show           = true
+    show_histogram = true
+  }
+
+  field {
+    unit     = "s"
+    decimals = 2
+  }
+
+  graph {
+    y_bucket_bound = "upper"
+    cell_gap       = 2
+    show_values    = "never"
+
+    color {
+      mode   = "scheme"
+      scheme = "Spectral"
+      steps  = 32
+    }
+
+    exemplars {
+      color = "red"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "sum(increase(http_request_duration_seconds_bucket{container_name='container'}[$__rate_interval])) by (le)"
+      format        = "heatmap"
+      legend_format = "{{le}}"
+    }
+  }
+}
+
+data "gdashboard_heatmap" "response_size" {
+  title = "Response size"
+
+  field {
+    unit = "bytes"
+  }
+
+  calculation {
+    x_buckets {
+      mode  = "size"
+      value = "1m"
+    }
+
+    y_buckets {
+      mode  = "count"
+      value = "10"
+
+      scale {
+        type = "log"
+        log  = 2
+      }
+    }
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "http_response_size_bytes{container_name='container'}"
+    }
+  }
+}
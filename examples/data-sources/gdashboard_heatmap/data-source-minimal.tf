@@ -0,0 +1,11 @@
+data "gdashboard_heatmap" "latency" {
+  title = "Request latency"
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(increase(http_request_duration_seconds_bucket{container_name='container'}[$__rate_interval])) by (le)"
+      format = "heatmap"
+    }
+  }
+}
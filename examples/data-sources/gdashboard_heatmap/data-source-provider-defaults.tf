@@ -0,0 +1,50 @@
+provider "gdashboard" {
+  defaults {
+    heatmap {
+      tooltip {
+        show_histogram = true
+      }
+
+      graph {
+        y_bucket_bound = "upper"
+
+        color {
+          mode   = "scheme"
+          scheme = "RdYlGn"
+        }
+      }
+    }
+  }
+}
+
+data "gdashboard_heatmap" "http_latency" {
+  title = "HTTP latency"
+
+  field {
+    unit = "s"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(increase(http_request_duration_seconds_bucket{container_name='container'}[$__rate_interval])) by (le)"
+      format = "heatmap"
+    }
+  }
+}
+
+data "gdashboard_heatmap" "grpc_latency" {
+  title = "gRPC latency"
+
+  field {
+    unit = "s"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(increase(grpc_server_handling_seconds_bucket{container_name='container'}[$__rate_interval])) by (le)"
+      format = "heatmap"
+    }
+  }
+}
@@ -0,0 +1,33 @@
+data "gdashboard_histogram" "response_size" {
+  title       = "Response size"
+  description = "The distribution of the HTTP response sizes"
+
+  legend {
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "single"
+  }
+
+  field {
+    unit = "bytes"
+  }
+
+  graph {
+    bucket_size    = 1024
+    bucket_offset  = 0
+    combine_series = true
+    fill_opacity   = 60
+    gradient_mode  = "opacity"
+    stack_series   = "none"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "http_response_size_bytes{container_name='container'}"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "gdashboard_histogram" "response_size" {
+  title = "Response size"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "http_response_size_bytes{container_name='container'}"
+    }
+  }
+}
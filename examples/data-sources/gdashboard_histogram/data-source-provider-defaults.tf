@@ -0,0 +1,40 @@
+provider "gdashboard" {
+  defaults {
+    histogram {
+      graph {
+        bucket_count   = 20
+        combine_series = true
+      }
+    }
+  }
+}
+
+data "gdashboard_histogram" "response_size" {
+  title = "Response size"
+
+  field {
+    unit = "bytes"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "http_response_size_bytes{container_name='container'}"
+    }
+  }
+}
+
+data "gdashboard_histogram" "request_size" {
+  title = "Request size"
+
+  field {
+    unit = "bytes"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "http_request_size_bytes{container_name='container'}"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "gdashboard_news" "changelog" {
+  title       = "Changelog"
+  description = "The latest releases of the platform"
+
+  graph {
+    feed_url   = "https://grafana.com/blog/news.xml"
+    show_image = false
+    use_proxy  = true
+  }
+}
@@ -0,0 +1,7 @@
+data "gdashboard_news" "changelog" {
+  title = "Changelog"
+
+  graph {
+    feed_url = "https://grafana.com/blog/news.xml"
+  }
+}
@@ -0,0 +1,33 @@
+data "gdashboard_node_graph" "service_map" {
+  title       = "Service map"
+  description = "The requests between the services"
+
+  nodes {
+    main_stat_unit      = "ms"
+    secondary_stat_unit = "reqps"
+
+    arc {
+      field = "arc__success"
+      color = "green"
+    }
+
+    arc {
+      field = "arc__failed"
+      color = "red"
+    }
+  }
+
+  edges {
+    main_stat_unit      = "reqps"
+    secondary_stat_unit = "percentunit"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (client, server) (rate(traces_service_graph_request_total[$__rate_interval]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "gdashboard_node_graph" "service_map" {
+  title = "Service map"
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (client, server) (rate(traces_service_graph_request_total[$__rate_interval]))"
+      instant = true
+      format  = "table"
+    }
+  }
+}
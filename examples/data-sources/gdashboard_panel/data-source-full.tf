@@ -0,0 +1,43 @@
+data "gdashboard_panel" "status" {
+  title       = "Status"
+  description = "The status of the services"
+  type        = "flant-statusmap-panel"
+
+  options = jsonencode({
+    cards = {
+      cardMinWidth = 5
+      cardSpacing  = 2
+    }
+    showItems = true
+  })
+
+  field_config_custom = jsonencode({
+    hideFrom = {
+      legend  = false
+      tooltip = false
+      viz     = false
+    }
+  })
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "up"
+      legend_format = "{{job}}"
+    }
+  }
+
+  field {
+    unit = "none"
+  }
+
+  overrides {
+    by_name {
+      name = "up"
+
+      field {
+        unit = "bool_on_off"
+      }
+    }
+  }
+}
@@ -0,0 +1,4 @@
+data "gdashboard_panel" "clock" {
+  title = "Clock"
+  type  = "grafana-clock-panel"
+}
@@ -0,0 +1,38 @@
+data "gdashboard_piechart" "memory" {
+  title       = "Memory usage by pod"
+  description = "Total memory consumed by each pod"
+
+  legend {
+    display_mode = "table"
+    placement    = "right"
+    values       = ["value", "percent"]
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit     = "bytes"
+    decimals = 1
+  }
+
+  graph {
+    pie_type = "donut"
+    labels   = ["name", "percent"]
+
+    options {
+      values      = false
+      fields      = "/.*/"
+      calculation = "lastNotNull"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "sum by (pod) (container_memory_usage_bytes{container_name='container'})"
+      legend_format = "{{pod}}"
+    }
+  }
+}
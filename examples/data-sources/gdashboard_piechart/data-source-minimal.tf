@@ -0,0 +1,10 @@
+data "gdashboard_piechart" "memory" {
+  title = "Memory usage by pod"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "sum by (pod) (container_memory_usage_bytes{container_name='container'})"
+    }
+  }
+}
@@ -0,0 +1,40 @@
+provider "gdashboard" {
+  defaults {
+    piechart {
+      legend {
+        display_mode = "table"
+        values       = ["percent"]
+      }
+
+      graph {
+        pie_type = "donut"
+      }
+    }
+  }
+}
+
+data "gdashboard_piechart" "memory" {
+  title = "Memory usage by pod"
+
+  field {
+    unit = "bytes"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "sum by (pod) (container_memory_usage_bytes{container_name='container'})"
+    }
+  }
+}
+
+data "gdashboard_piechart" "cpu" {
+  title = "CPU usage by pod"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "sum by (pod) (rate(container_cpu_usage_seconds_total{container_name='container'}[$__rate_interval]))"
+    }
+  }
+}
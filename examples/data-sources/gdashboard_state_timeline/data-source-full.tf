@@ -0,0 +1,51 @@
+data "gdashboard_state_timeline" "health" {
+  title       = "Service health"
+  description = "Shows whether the service instances are up"
+
+  legend {
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "single"
+  }
+
+  field {
+    mappings {
+      value {
+        value        = "1"
+        display_text = "UP"
+        color        = "green"
+      }
+
+      value {
+        value        = "0"
+        display_text = "DOWN"
+        color        = "red"
+      }
+
+      special {
+        match        = "null"
+        display_text = "UNKNOWN"
+        color        = "gray"
+      }
+    }
+  }
+
+  graph {
+    merge_values = true
+    show_values  = "never"
+    row_height   = 0.8
+    line_width   = 1
+    fill_opacity = 80
+  }
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "up{job='api'}"
+      legend_format = "{{instance}}"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "gdashboard_state_timeline" "health" {
+  title = "Service health"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "up{job='api'}"
+    }
+  }
+}
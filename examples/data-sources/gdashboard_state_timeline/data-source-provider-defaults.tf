@@ -0,0 +1,36 @@
+provider "gdashboard" {
+  defaults {
+    state_timeline {
+      legend {
+        display_mode = "hidden"
+      }
+
+      graph {
+        show_values  = "never"
+        fill_opacity = 90
+      }
+    }
+  }
+}
+
+data "gdashboard_state_timeline" "api_health" {
+  title = "API health"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "up{job='api'}"
+    }
+  }
+}
+
+data "gdashboard_state_timeline" "deployments" {
+  title = "Deployment status"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "kube_deployment_status_condition{condition='Available', status='true'}"
+    }
+  }
+}
@@ -0,0 +1,60 @@
+data "gdashboard_status_history" "cpu" {
+  title       = "CPU usage per host"
+  description = "Shows the CPU usage of every host"
+
+  legend {
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "single"
+  }
+
+  field {
+    unit = "percent"
+
+    color {
+      mode = "thresholds"
+    }
+
+    thresholds {
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "orange"
+        value = 70
+      }
+
+      step {
+        color = "red"
+        value = 90
+      }
+    }
+
+    mappings {
+      range {
+        from         = 90
+        to           = 100
+        display_text = "Critical"
+      }
+    }
+  }
+
+  graph {
+    show_values  = "never"
+    row_height   = 0.8
+    column_width = 0.9
+    fill_opacity = 80
+  }
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "100 - avg by (instance) (rate(node_cpu_seconds_total{mode='idle'}[$__rate_interval])) * 100"
+      legend_format = "{{instance}}"
+    }
+  }
+}
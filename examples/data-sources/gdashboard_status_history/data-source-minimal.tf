@@ -0,0 +1,10 @@
+data "gdashboard_status_history" "cpu" {
+  title = "CPU usage per host"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "100 - avg by (instance) (rate(node_cpu_seconds_total{mode='idle'}[$__rate_interval])) * 100"
+    }
+  }
+}
@@ -0,0 +1,46 @@
+provider "gdashboard" {
+  defaults {
+    status_history {
+      field {
+        color {
+          mode = "thresholds"
+        }
+      }
+
+      graph {
+        show_values  = "never"
+        column_width = 1
+      }
+    }
+  }
+}
+
+data "gdashboard_status_history" "cpu" {
+  title = "CPU usage per host"
+
+  field {
+    unit = "percent"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "100 - avg by (instance) (rate(node_cpu_seconds_total{mode='idle'}[$__rate_interval])) * 100"
+    }
+  }
+}
+
+data "gdashboard_status_history" "memory" {
+  title = "Memory usage per host"
+
+  field {
+    unit = "percent"
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "100 * (1 - node_memory_MemAvailable_bytes / node_memory_MemTotal_bytes)"
+    }
+  }
+}
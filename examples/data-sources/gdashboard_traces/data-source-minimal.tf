@@ -0,0 +1,4 @@
+data "gdashboard_traces" "trace" {
+  title       = "Trace"
+  description = "The trace of the selected request"
+}
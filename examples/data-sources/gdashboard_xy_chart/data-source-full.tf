@@ -0,0 +1,59 @@
+data "gdashboard_xy_chart" "cpu_vs_rps" {
+  title       = "CPU vs. throughput"
+  description = "Shows how the CPU usage grows with the number of requests"
+
+  legend {
+    display_mode = "list"
+    placement    = "bottom"
+  }
+
+  tooltip {
+    mode = "single"
+  }
+
+  field {
+    unit = "percentunit"
+  }
+
+  axis {
+    label     = "CPU"
+    placement = "left"
+    soft_min  = 0
+  }
+
+  graph {
+    series_mapping = "manual"
+    show           = "points"
+    point_size     = 6
+    point_size_min = 2
+    point_size_max = 30
+  }
+
+  series {
+    name       = "CPU"
+    x_field    = "rps"
+    y_field    = "cpu"
+    size_field = "pods"
+    color      = "orange"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(http_requests_total{container_name='container'}[$__rate_interval]))"
+      ref_id = "rps"
+    }
+
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(container_cpu_usage_seconds_total{container_name='container'}[$__rate_interval]))"
+      ref_id = "cpu"
+    }
+
+    prometheus {
+      uid    = "prometheus"
+      expr   = "count(kube_pod_info{container_name='container'})"
+      ref_id = "pods"
+    }
+  }
+}
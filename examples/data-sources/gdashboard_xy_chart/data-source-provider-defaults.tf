@@ -0,0 +1,54 @@
+provider "gdashboard" {
+  defaults {
+    xy_chart {
+      graph {
+        show       = "both"
+        point_size = 4
+      }
+    }
+  }
+}
+
+data "gdashboard_xy_chart" "cpu_vs_rps" {
+  title = "CPU vs. throughput"
+
+  graph {
+    x_field = "rps"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(http_requests_total{container_name='container'}[$__rate_interval]))"
+      ref_id = "rps"
+    }
+
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(container_cpu_usage_seconds_total{container_name='container'}[$__rate_interval]))"
+      ref_id = "cpu"
+    }
+  }
+}
+
+data "gdashboard_xy_chart" "memory_vs_rps" {
+  title = "Memory vs. throughput"
+
+  graph {
+    x_field = "rps"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(http_requests_total{container_name='container'}[$__rate_interval]))"
+      ref_id = "rps"
+    }
+
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(container_memory_usage_bytes{container_name='container'})"
+      ref_id = "memory"
+    }
+  }
+}
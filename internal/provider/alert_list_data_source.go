@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AlertListDataSource{}
+
+func NewAlertListDataSource() datasource.DataSource {
+	return &AlertListDataSource{}
+}
+
+// AlertListDataSource defines the data source implementation.
+type AlertListDataSource struct {
+	CompactJson bool
+}
+
+// AlertListDataSourceModel describes the data source data model.
+type AlertListDataSourceModel struct {
+	Id          types.String       `tfsdk:"id"`
+	Json        types.String       `tfsdk:"json"`
+	CompactJson types.Bool         `tfsdk:"compact_json"`
+	Title       types.String       `tfsdk:"title"`
+	Description types.String       `tfsdk:"description"`
+	Graph       []AlertListOptions `tfsdk:"graph"`
+}
+
+type AlertListOptions struct {
+	ViewMode          types.String                  `tfsdk:"view_mode"`
+	GroupBy           []types.String                `tfsdk:"group_by"`
+	SortOrder         types.String                  `tfsdk:"sort_order"`
+	Limit             types.Int64                   `tfsdk:"limit"`
+	OnlyThisDashboard types.Bool                    `tfsdk:"only_this_dashboard"`
+	ShowInstances     types.Bool                    `tfsdk:"show_instances"`
+	ShowInactive      types.Bool                    `tfsdk:"show_inactive"`
+	AlertName         types.String                  `tfsdk:"alert_name"`
+	LabelFilter       types.String                  `tfsdk:"label_filter"`
+	FolderUID         types.String                  `tfsdk:"folder_uid"`
+	Datasource        types.String                  `tfsdk:"datasource"`
+	StateFilter       []AlertListStateFilterOptions `tfsdk:"state_filter"`
+}
+
+type AlertListStateFilterOptions struct {
+	Firing     types.Bool `tfsdk:"firing"`
+	Pending    types.Bool `tfsdk:"pending"`
+	Recovering types.Bool `tfsdk:"recovering"`
+	NoData     types.Bool `tfsdk:"no_data"`
+	Normal     types.Bool `tfsdk:"normal"`
+	Error      types.Bool `tfsdk:"error"`
+}
+
+func (d *AlertListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_list"
+}
+
+func alertListGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"state_filter": schema.ListNestedBlock{
+					Description: "The alert states to show. By default, the firing, pending and error alerts are shown.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"firing": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the firing alerts or not.",
+							},
+							"pending": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the pending alerts or not.",
+							},
+							"recovering": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the recovering alerts or not.",
+							},
+							"no_data": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the alerts without data or not.",
+							},
+							"normal": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the normal alerts or not.",
+							},
+							"error": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the alerts in the error state or not.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"view_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The display mode of the alerts. The choices are: list, stat.",
+					MarkdownDescription: "The display mode of the alerts. The choices are: `list`, `stat`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("list", "stat"),
+					},
+				},
+				"group_by": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "The labels to group the alert instances by. By default, the alerts are grouped by the alert rule.",
+				},
+				"sort_order": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The sort order of the alerts. The choices are: alphabetical_asc, alphabetical_desc, importance, time_asc, time_desc.",
+					MarkdownDescription: "The sort order of the alerts. The choices are: `alphabetical_asc`, `alphabetical_desc`, `importance`, `time_asc`, `time_desc`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("alphabetical_asc", "alphabetical_desc", "importance", "time_asc", "time_desc"),
+					},
+				},
+				"limit": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The maximum number of alerts to show. Defaults to 20.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"only_this_dashboard": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show only the alerts of the current dashboard or not.",
+				},
+				"show_instances": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the alert instances or not.",
+				},
+				"show_inactive": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the alert rules without instances or not.",
+				},
+				"alert_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The alert name to filter the alerts by.",
+				},
+				"label_filter": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The label matchers to filter the alert instances by. For example: {severity=\"critical\", team=~\"infra|platform\"}.",
+					MarkdownDescription: "The label matchers to filter the alert instances by. For example: `{severity=\"critical\", team=~\"infra|platform\"}`.",
+				},
+				"folder_uid": schema.StringAttribute{
+					Optional:    true,
+					Description: "The UID of the folder to filter the alert rules by.",
+				},
+				"datasource": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the data source to filter the alert rules by.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *AlertListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Alert list panel data source.",
+		MarkdownDescription: "Alert list panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/alert-list/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"graph": alertListGraphBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *AlertListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *AlertListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertListDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := grafana.AlertlistOptions{
+		ViewMode:                 "list",
+		GroupMode:                "default",
+		GroupBy:                  []string{},
+		MaxItems:                 20,
+		SortOrder:                1,
+		DashboardAlerts:          false,
+		AlertName:                "",
+		AlertInstanceLabelFilter: "",
+		ShowInstances:            false,
+		ShowInactiveAlerts:       false,
+		StateFilter: grafana.AlertlistStateFilter{
+			Firing:     true,
+			Pending:    true,
+			Recovering: false,
+			NoData:     false,
+			Normal:     false,
+			Error:      true,
+		},
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.ViewMode.IsNull() {
+			options.ViewMode = graph.ViewMode.ValueString()
+		}
+
+		if len(graph.GroupBy) > 0 {
+			groupBy := make([]string, len(graph.GroupBy))
+			for i, label := range graph.GroupBy {
+				groupBy[i] = label.ValueString()
+			}
+
+			options.GroupMode = "custom"
+			options.GroupBy = groupBy
+		}
+
+		switch graph.SortOrder.ValueString() {
+		case "alphabetical_asc":
+			options.SortOrder = 1
+		case "alphabetical_desc":
+			options.SortOrder = 2
+		case "importance":
+			options.SortOrder = 3
+		case "time_asc":
+			options.SortOrder = 4
+		case "time_desc":
+			options.SortOrder = 5
+		}
+
+		if !graph.Limit.IsNull() {
+			options.MaxItems = graph.Limit.ValueInt64()
+		}
+
+		if !graph.OnlyThisDashboard.IsNull() {
+			options.DashboardAlerts = graph.OnlyThisDashboard.ValueBool()
+		}
+
+		if !graph.ShowInstances.IsNull() {
+			options.ShowInstances = graph.ShowInstances.ValueBool()
+		}
+
+		if !graph.ShowInactive.IsNull() {
+			options.ShowInactiveAlerts = graph.ShowInactive.ValueBool()
+		}
+
+		if !graph.AlertName.IsNull() {
+			options.AlertName = graph.AlertName.ValueString()
+		}
+
+		if !graph.LabelFilter.IsNull() {
+			options.AlertInstanceLabelFilter = graph.LabelFilter.ValueString()
+		}
+
+		if !graph.FolderUID.IsNull() {
+			options.Folder = &grafana.AlertlistFolder{
+				UID: graph.FolderUID.ValueString(),
+			}
+		}
+
+		if !graph.Datasource.IsNull() {
+			options.Datasource = graph.Datasource.ValueString()
+		}
+
+		for _, state := range graph.StateFilter {
+			if !state.Firing.IsNull() {
+				options.StateFilter.Firing = state.Firing.ValueBool()
+			}
+
+			if !state.Pending.IsNull() {
+				options.StateFilter.Pending = state.Pending.ValueBool()
+			}
+
+			if !state.Recovering.IsNull() {
+				options.StateFilter.Recovering = state.Recovering.ValueBool()
+			}
+
+			if !state.NoData.IsNull() {
+				options.StateFilter.NoData = state.NoData.ValueBool()
+			}
+
+			if !state.Normal.IsNull() {
+				options.StateFilter.Normal = state.Normal.ValueBool()
+			}
+
+			if !state.Error.IsNull() {
+				options.StateFilter.Error = state.Error.ValueBool()
+			}
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType: grafana.AlertlistType,
+			Title:  data.Title.ValueString(),
+			Type:   "alertlist",
+			Span:   12,
+			IsNew:  true,
+		},
+		AlertlistPanel: &grafana.AlertlistPanel{
+			Options: &options,
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlertListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAlertListDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_alert_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_alert_list.test", "json", testAccAlertListDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccAlertListDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_alert_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_alert_list.test", "json", testAccAlertListDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccAlertListDataSourceConfig = `
+data "gdashboard_alert_list" "test" {
+  title       = "Test"
+  description = "Alert list description"
+
+  graph {
+    view_mode           = "stat"
+    group_by            = ["team", "severity"]
+    sort_order          = "time_desc"
+    limit               = 50
+    only_this_dashboard = true
+    show_instances      = true
+    show_inactive       = true
+    alert_name          = "HighErrorRate"
+    label_filter        = "{severity=\"critical\"}"
+    folder_uid          = "alerts"
+    datasource          = "Prometheus"
+
+    state_filter {
+      firing     = true
+      pending    = false
+      recovering = true
+      no_data    = true
+    }
+  }
+}
+`
+
+const testAccAlertListDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Alert list description",
+  "transparent": false,
+  "type": "alertlist",
+  "onlyAlertsOnDashboard": false,
+  "show": "",
+  "sortOrder": 0,
+  "limit": 0,
+  "stateFilter": null,
+  "options": {
+    "viewMode": "stat",
+    "groupMode": "custom",
+    "groupBy": [
+      "team",
+      "severity"
+    ],
+    "maxItems": 50,
+    "sortOrder": 5,
+    "dashboardAlerts": true,
+    "alertName": "HighErrorRate",
+    "alertInstanceLabelFilter": "{severity=\"critical\"}",
+    "datasource": "Prometheus",
+    "folder": {
+      "uid": "alerts"
+    },
+    "showInstances": true,
+    "showInactiveAlerts": true,
+    "stateFilter": {
+      "firing": true,
+      "pending": false,
+      "recovering": true,
+      "noData": true,
+      "normal": false,
+      "error": true
+    }
+  }
+}`
+
+const testAccAlertListDataSourceProviderDefaultsConfig = `
+data "gdashboard_alert_list" "test" {
+  title = "Test"
+}
+`
+
+const testAccAlertListDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "alertlist",
+  "onlyAlertsOnDashboard": false,
+  "show": "",
+  "sortOrder": 0,
+  "limit": 0,
+  "stateFilter": null,
+  "options": {
+    "viewMode": "list",
+    "groupMode": "default",
+    "groupBy": [],
+    "maxItems": 20,
+    "sortOrder": 1,
+    "dashboardAlerts": false,
+    "alertName": "",
+    "alertInstanceLabelFilter": "",
+    "showInstances": false,
+    "showInactiveAlerts": false,
+    "stateFilter": {
+      "firing": true,
+      "pending": true,
+      "recovering": false,
+      "noData": false,
+      "normal": false,
+      "error": true
+    }
+  }
+}`
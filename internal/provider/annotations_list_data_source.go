@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AnnotationsListDataSource{}
+
+func NewAnnotationsListDataSource() datasource.DataSource {
+	return &AnnotationsListDataSource{}
+}
+
+// AnnotationsListDataSource defines the data source implementation.
+type AnnotationsListDataSource struct {
+	CompactJson bool
+}
+
+// AnnotationsListDataSourceModel describes the data source data model.
+type AnnotationsListDataSourceModel struct {
+	Id          types.String             `tfsdk:"id"`
+	Json        types.String             `tfsdk:"json"`
+	CompactJson types.Bool               `tfsdk:"compact_json"`
+	Title       types.String             `tfsdk:"title"`
+	Description types.String             `tfsdk:"description"`
+	Graph       []AnnotationsListOptions `tfsdk:"graph"`
+}
+
+type AnnotationsListOptions struct {
+	OnlyThisDashboard types.Bool     `tfsdk:"only_this_dashboard"`
+	OnlyInTimeRange   types.Bool     `tfsdk:"only_in_time_range"`
+	Tags              []types.String `tfsdk:"tags"`
+	Limit             types.Int64    `tfsdk:"limit"`
+	ShowUser          types.Bool     `tfsdk:"show_user"`
+	ShowTime          types.Bool     `tfsdk:"show_time"`
+	ShowTags          types.Bool     `tfsdk:"show_tags"`
+	NavigateToPanel   types.Bool     `tfsdk:"navigate_to_panel"`
+	NavigateBefore    types.String   `tfsdk:"navigate_before"`
+	NavigateAfter     types.String   `tfsdk:"navigate_after"`
+}
+
+func (d *AnnotationsListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotations_list"
+}
+
+func annotationsListGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"only_this_dashboard": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show only the annotations of the current dashboard or not. Defaults to false.",
+				},
+				"only_in_time_range": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show only the annotations within the selected time range of the dashboard or not. Defaults to false.",
+				},
+				"tags": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "The tags to filter the annotations by.",
+				},
+				"limit": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The maximum number of annotations to show. Defaults to 10.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"show_user": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the user who created the annotation or not. Defaults to true.",
+				},
+				"show_time": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the time of the annotation or not. Defaults to true.",
+				},
+				"show_tags": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the tags of the annotation or not. Defaults to true.",
+				},
+				"navigate_to_panel": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to navigate to the panel of the annotation on click or not. Defaults to true.",
+				},
+				"navigate_before": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The time to show before the annotation when navigating to it. Defaults to 10m.",
+					MarkdownDescription: "The time to show before the annotation when navigating to it. Defaults to `10m`.",
+				},
+				"navigate_after": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The time to show after the annotation when navigating to it. Defaults to 10m.",
+					MarkdownDescription: "The time to show after the annotation when navigating to it. Defaults to `10m`.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *AnnotationsListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Annotations list panel data source.",
+		MarkdownDescription: "Annotations list panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/annotations/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"graph": annotationsListGraphBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *AnnotationsListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *AnnotationsListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AnnotationsListDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := grafana.AnnotationsListPanelOptions{
+		OnlyFromThisDashboard: false,
+		OnlyInTimeRange:       false,
+		Tags:                  []string{},
+		Limit:                 10,
+		ShowUser:              true,
+		ShowTime:              true,
+		ShowTags:              true,
+		NavigateToPanel:       true,
+		NavigateBefore:        "10m",
+		NavigateAfter:         "10m",
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.OnlyThisDashboard.IsNull() {
+			options.OnlyFromThisDashboard = graph.OnlyThisDashboard.ValueBool()
+		}
+
+		if !graph.OnlyInTimeRange.IsNull() {
+			options.OnlyInTimeRange = graph.OnlyInTimeRange.ValueBool()
+		}
+
+		for _, tag := range graph.Tags {
+			options.Tags = append(options.Tags, tag.ValueString())
+		}
+
+		if !graph.Limit.IsNull() {
+			options.Limit = graph.Limit.ValueInt64()
+		}
+
+		if !graph.ShowUser.IsNull() {
+			options.ShowUser = graph.ShowUser.ValueBool()
+		}
+
+		if !graph.ShowTime.IsNull() {
+			options.ShowTime = graph.ShowTime.ValueBool()
+		}
+
+		if !graph.ShowTags.IsNull() {
+			options.ShowTags = graph.ShowTags.ValueBool()
+		}
+
+		if !graph.NavigateToPanel.IsNull() {
+			options.NavigateToPanel = graph.NavigateToPanel.ValueBool()
+		}
+
+		if !graph.NavigateBefore.IsNull() {
+			options.NavigateBefore = graph.NavigateBefore.ValueString()
+		}
+
+		if !graph.NavigateAfter.IsNull() {
+			options.NavigateAfter = graph.NavigateAfter.ValueString()
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType: grafana.AnnotationsListType,
+			Title:  data.Title.ValueString(),
+			Type:   "annolist",
+			Span:   12,
+			IsNew:  true,
+		},
+		AnnotationsListPanel: &grafana.AnnotationsListPanel{
+			Options: options,
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnnotationsListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAnnotationsListDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_annotations_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_annotations_list.test", "json", testAccAnnotationsListDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccAnnotationsListDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_annotations_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_annotations_list.test", "json", testAccAnnotationsListDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccAnnotationsListDataSourceConfig = `
+data "gdashboard_annotations_list" "test" {
+  title       = "Test"
+  description = "Annotations list description"
+
+  graph {
+    only_this_dashboard = true
+    only_in_time_range  = true
+    tags                = ["deploy", "release"]
+    limit               = 25
+    show_user           = false
+    show_time           = false
+    show_tags           = false
+    navigate_to_panel   = false
+    navigate_before     = "5m"
+    navigate_after      = "15m"
+  }
+}
+`
+
+const testAccAnnotationsListDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Annotations list description",
+  "transparent": false,
+  "type": "annolist",
+  "options": {
+    "onlyFromThisDashboard": true,
+    "onlyInTimeRange": true,
+    "tags": [
+      "deploy",
+      "release"
+    ],
+    "limit": 25,
+    "showUser": false,
+    "showTime": false,
+    "showTags": false,
+    "navigateToPanel": false,
+    "navigateBefore": "5m",
+    "navigateAfter": "15m"
+  }
+}`
+
+const testAccAnnotationsListDataSourceProviderDefaultsConfig = `
+data "gdashboard_annotations_list" "test" {
+  title = "Test"
+}
+`
+
+const testAccAnnotationsListDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "annolist",
+  "options": {
+    "onlyFromThisDashboard": false,
+    "onlyInTimeRange": false,
+    "tags": [],
+    "limit": 10,
+    "showUser": true,
+    "showTime": true,
+    "showTags": true,
+    "navigateToPanel": true,
+    "navigateBefore": "10m",
+    "navigateAfter": "10m"
+  }
+}`
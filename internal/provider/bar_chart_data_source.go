@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BarChartDataSource{}
+
+func NewBarChartDataSource() datasource.DataSource {
+	return &BarChartDataSource{}
+}
+
+// BarChartDataSource defines the data source implementation.
+type BarChartDataSource struct {
+	CompactJson bool
+	Defaults    BarChartDefaults
+}
+
+type BarChartDefaults struct {
+	Legend  TimeseriesLegendDefault
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Axis    AxisDefaults
+	Graph   BarChartGraphDefaults
+}
+
+type BarChartGraphDefaults struct {
+	Orientation        string
+	XField             string
+	GroupWidth         float64
+	BarWidth           float64
+	BarRadius          float64
+	Stacking           string
+	ShowValues         string
+	ValueLabelRotation int
+}
+
+// BarChartDataSourceModel describes the data source data model.
+type BarChartDataSourceModel struct {
+	Id              types.String               `tfsdk:"id"`
+	Json            types.String               `tfsdk:"json"`
+	CompactJson     types.Bool                 `tfsdk:"compact_json"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Queries         []Query                    `tfsdk:"queries"`
+	Legend          []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field           []FieldOptions             `tfsdk:"field"`
+	Axis            []AxisOptions              `tfsdk:"axis"`
+	Graph           []BarChartGraphOptions     `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions     `tfsdk:"overrides"`
+	Transformations []Transformations          `tfsdk:"transform"`
+}
+
+type BarChartGraphOptions struct {
+	Orientation        types.String  `tfsdk:"orientation"`
+	XField             types.String  `tfsdk:"x_field"`
+	GroupWidth         types.Float64 `tfsdk:"group_width"`
+	BarWidth           types.Float64 `tfsdk:"bar_width"`
+	BarRadius          types.Float64 `tfsdk:"bar_radius"`
+	Stacking           types.String  `tfsdk:"stacking"`
+	ShowValues         types.String  `tfsdk:"show_values"`
+	ValueLabelRotation types.Int64   `tfsdk:"value_label_rotation"`
+}
+
+func (d *BarChartDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bar_chart"
+}
+
+func barChartGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"orientation": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The orientation of the bars. The choices are: auto, horizontal, vertical.",
+					MarkdownDescription: "The orientation of the bars. The choices are: `auto`, `horizontal`, `vertical`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "horizontal", "vertical"),
+					},
+				},
+				"x_field": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field to use for the x-axis. By default, the first string field is used.",
+				},
+				"group_width": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The width of the bar group. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "The width of the bar group. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"bar_width": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The width of the bar relative to the group. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "The width of the bar relative to the group. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"bar_radius": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The radius of the bar corners. Must be between 0 and 0.5 (inclusive).",
+					MarkdownDescription: "The radius of the bar corners. Must be between `0` and `0.5` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 0.5),
+					},
+				},
+				"stacking": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to stack the bars. The choices are: none, normal, percent.",
+					MarkdownDescription: "Choose how to stack the bars. The choices are: `none`, `normal`, `percent`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "normal", "percent"),
+					},
+				},
+				"show_values": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Controls whether values are shown on top or to the left of bars. The choices are: auto, always, never.",
+					MarkdownDescription: "Controls whether values are shown on top or to the left of bars. The choices are: `auto`, `always`, `never`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "always", "never"),
+					},
+				},
+				"value_label_rotation": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The rotation angle of the x-axis labels. Must be between -90 and 90 (inclusive).",
+					MarkdownDescription: "The rotation angle of the x-axis labels. Must be between `-90` and `90` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(-90, 90),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *BarChartDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Bar chart panel data source.",
+		MarkdownDescription: "Bar chart panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/bar-chart/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    timeseriesLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(true),
+			"axis":      axisBlock(),
+			"graph":     barChartGraphBlock(),
+			"overrides": fieldOverrideBlock(true),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *BarChartDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.BarChart
+}
+
+func (d *BarChartDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BarChartDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       d.Defaults.Legend.Calculations,
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if len(legend.Calculations) > 0 {
+			calculations := make([]string, len(legend.Calculations))
+			for i, calc := range legend.Calculations {
+				calculations[i] = calc.ValueString()
+			}
+
+			legendOptions.Calcs = calculations
+		}
+
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	options := grafana.BarChartOptions{
+		Orientation:        d.Defaults.Graph.Orientation,
+		XField:             d.Defaults.Graph.XField,
+		GroupWidth:         d.Defaults.Graph.GroupWidth,
+		BarWidth:           d.Defaults.Graph.BarWidth,
+		BarRadius:          d.Defaults.Graph.BarRadius,
+		Stacking:           d.Defaults.Graph.Stacking,
+		ShowValue:          d.Defaults.Graph.ShowValues,
+		XTickLabelRotation: d.Defaults.Graph.ValueLabelRotation,
+		Legend:             legendOptions,
+		Tooltip:            tooltipOptions,
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.Orientation.IsNull() {
+			options.Orientation = graph.Orientation.ValueString()
+		}
+
+		if !graph.XField.IsNull() {
+			options.XField = graph.XField.ValueString()
+		}
+
+		if !graph.GroupWidth.IsNull() {
+			options.GroupWidth = graph.GroupWidth.ValueFloat64()
+		}
+
+		if !graph.BarWidth.IsNull() {
+			options.BarWidth = graph.BarWidth.ValueFloat64()
+		}
+
+		if !graph.BarRadius.IsNull() {
+			options.BarRadius = graph.BarRadius.ValueFloat64()
+		}
+
+		if !graph.Stacking.IsNull() {
+			options.Stacking = graph.Stacking.ValueString()
+		}
+
+		if !graph.ShowValues.IsNull() {
+			options.ShowValue = graph.ShowValues.ValueString()
+		}
+
+		if !graph.ValueLabelRotation.IsNull() {
+			options.XTickLabelRotation = int(graph.ValueLabelRotation.ValueInt64())
+		}
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		LineWidth:    1,
+		FillOpacity:  80,
+		GradientMode: "none",
+		// axis
+		AxisLabel:     d.Defaults.Axis.Label,
+		AxisPlacement: d.Defaults.Axis.Placement,
+		AxisSoftMin:   d.Defaults.Axis.SoftMin,
+		AxisSoftMax:   d.Defaults.Axis.SoftMax,
+	}
+
+	fieldConfig.Custom.ScaleDistribution.Type = d.Defaults.Axis.Scale.Type
+	fieldConfig.Custom.ScaleDistribution.Log = d.Defaults.Axis.Scale.Log
+	fieldConfig.Custom.ThresholdsStyle.Mode = d.Defaults.Field.Thresholds.ShowAs
+
+	updateAxis(&fieldConfig.Custom, data.Axis)
+
+	for _, field := range data.Field {
+		for _, threshold := range field.Thresholds {
+			if !threshold.ShowAs.IsNull() {
+				fieldConfig.Custom.ThresholdsStyle.Mode = threshold.ShowAs.ValueString()
+			}
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.BarChartType,
+			Title:           data.Title.ValueString(),
+			Type:            "barchart",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		BarChartPanel: &grafana.BarChartPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBarChartDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBarChartDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "json", testAccBarChartDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccBarChartDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "json", testAccBarChartDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccBarChartDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_bar_chart.test", "json", testAccBarChartDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccBarChartDataSourceConfig = `
+data "gdashboard_bar_chart" "test" {
+  title       = "Test"
+  description = "Bar chart description"
+
+  legend {
+    calculations = ["max"]
+    display_mode = "table"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit = "reqps"
+
+    thresholds {
+      show_as = "line"
+
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "red"
+        value = 100
+      }
+    }
+  }
+
+  axis {
+    label     = "Requests"
+    placement = "left"
+    soft_min  = 0
+
+    scale {
+      type = "log"
+      log  = 10
+    }
+  }
+
+  graph {
+    orientation          = "horizontal"
+    x_field              = "handler"
+    group_width          = 0.5
+    bar_width            = 0.8
+    bar_radius           = 0.1
+    stacking             = "normal"
+    show_values          = "always"
+    value_label_rotation = -45
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "topk(10, sum by (handler) (rate(http_requests_total[$__rate_interval])))"
+      ref_id  = "Prometheus_Query"
+      instant = true
+      format  = "table"
+    }
+
+    azure_monitor {
+      uid = "azure"
+
+      resource_graph {
+        query         = "Resources | summarize count() by type"
+        subscriptions = ["00000000-0000-0000-0000-000000000000"]
+      }
+    }
+
+    google_cloud_monitoring {
+      uid = "stackdriver"
+
+      promql {
+        project = "my-project"
+        expr    = "topk(10, sum by (instance_name) (rate(compute_googleapis_com:instance_network_received_bytes_count[5m])))"
+        step    = "10s"
+      }
+    }
+  }
+}
+`
+
+const testAccBarChartDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Bar chart description",
+  "transparent": false,
+  "type": "barchart",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "topk(10, sum by (handler) (rate(http_requests_total[$__rate_interval])))",
+      "instant": true,
+      "format": "table"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "azure",
+        "name": "",
+        "type": "grafana-azure-monitor-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "Azure Resource Graph",
+      "subscriptions": [
+        "00000000-0000-0000-0000-000000000000"
+      ],
+      "azureResourceGraph": {
+        "query": "Resources | summarize count() by type",
+        "resultFormat": "table"
+      }
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "stackdriver",
+        "name": "",
+        "type": "stackdriver",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "promQL",
+      "promQLQuery": {
+        "projectName": "my-project",
+        "expr": "topk(10, sum by (instance_name) (rate(compute_googleapis_com:instance_network_received_bytes_count[5m])))",
+        "step": "10s"
+      }
+    }
+  ],
+  "options": {
+    "orientation": "horizontal",
+    "xField": "handler",
+    "groupWidth": 0.5,
+    "barWidth": 0.8,
+    "barRadius": 0.1,
+    "stacking": "normal",
+    "showValue": "always",
+    "xTickLabelRotation": -45,
+    "legend": {
+      "calcs": [
+        "max"
+      ],
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "reqps",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          },
+          {
+            "color": "red",
+            "value": 100
+          }
+        ]
+      },
+      "custom": {
+        "axisLabel": "Requests",
+        "axisPlacement": "left",
+        "axisSoftMin": 0,
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 80,
+        "gradientMode": "none",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "log",
+          "log": 10
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": "line"
+        }
+      }
+    }
+  }
+}`
+
+const testAccBarChartDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    bar_chart {
+      legend {
+        calculations = ["mean"]
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "short"
+      }
+
+      axis {
+        placement = "hidden"
+      }
+
+      graph {
+        orientation          = "vertical"
+        group_width          = 0.9
+        bar_width            = 1
+        bar_radius           = 0.5
+        stacking             = "percent"
+        show_values          = "never"
+        value_label_rotation = 90
+      }
+    }
+  }
+}
+
+data "gdashboard_bar_chart" "test" {
+  title = "Test"
+}
+`
+
+const testAccBarChartDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "barchart",
+  "options": {
+    "orientation": "vertical",
+    "groupWidth": 0.9,
+    "barWidth": 1,
+    "barRadius": 0.5,
+    "stacking": "percent",
+    "showValue": "never",
+    "xTickLabelRotation": 90,
+    "legend": {
+      "calcs": [
+        "mean"
+      ],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "short",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "hidden",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 80,
+        "gradientMode": "none",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccBarChartDataSourceProviderDefaultsConfig = `
+data "gdashboard_bar_chart" "test" {
+  title = "Test"
+}
+`
+
+const testAccBarChartDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "barchart",
+  "options": {
+    "orientation": "auto",
+    "groupWidth": 0.7,
+    "barWidth": 0.97,
+    "barRadius": 0,
+    "stacking": "none",
+    "showValue": "auto",
+    "xTickLabelRotation": 0,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 80,
+        "gradientMode": "none",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -19,6 +19,20 @@ func TestAccBarGaugeDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "json", testAccBarGaugeDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccBarGaugeDataSourceAzureMonitorConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "json", testAccBarGaugeDataSourceAzureMonitorConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccBarGaugeDataSourceCloudMonitoringConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "json", testAccBarGaugeDataSourceCloudMonitoringConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccBarGaugeDataSourceProviderCustomDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -276,6 +290,326 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
   }
 }`
 
+const testAccBarGaugeDataSourceAzureMonitorConfig = `
+data "gdashboard_bar_gauge" "test" {
+  title = "Test"
+
+  queries {
+    azure_monitor {
+      uid    = "azure"
+      ref_id = "Azure_Query"
+
+      metrics {
+        subscription   = "00000000-0000-0000-0000-000000000000"
+        resource_group = "production"
+        namespace      = "Microsoft.Compute/virtualMachines"
+        resource_name  = "vm-01"
+        region         = "westeurope"
+        metric_name    = "Percentage CPU"
+        aggregation    = "Average"
+        top            = 10
+        alias          = "{{ resourcename }}"
+
+        dimension_filter {
+          dimension = "LUN"
+        }
+
+        dimension_filter {
+          dimension = "Tier"
+          operator  = "ne"
+          values    = ["Hot", "Cool"]
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccBarGaugeDataSourceAzureMonitorConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "bargauge",
+  "options": {
+    "orientation": "auto",
+    "textMode": "",
+    "colorMode": "",
+    "graphMode": "",
+    "justifyMode": "",
+    "displayMode": "gradient",
+    "content": "",
+    "mode": "",
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "targets": [
+    {
+      "refId": "Azure_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "azure",
+        "name": "",
+        "type": "grafana-azure-monitor-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "Azure Monitor",
+      "subscription": "00000000-0000-0000-0000-000000000000",
+      "azureMonitor": {
+        "resources": [
+          {
+            "subscription": "00000000-0000-0000-0000-000000000000",
+            "resourceGroup": "production",
+            "metricNamespace": "Microsoft.Compute/virtualMachines",
+            "resourceName": "vm-01",
+            "region": "westeurope"
+          }
+        ],
+        "metricNamespace": "Microsoft.Compute/virtualMachines",
+        "metricName": "Percentage CPU",
+        "aggregation": "Average",
+        "timeGrain": "auto",
+        "dimensionFilters": [
+          {
+            "dimension": "LUN",
+            "operator": "eq",
+            "filters": []
+          },
+          {
+            "dimension": "Tier",
+            "operator": "ne",
+            "filters": [
+              "Hot",
+              "Cool"
+            ]
+          }
+        ],
+        "top": "10",
+        "alias": "{{ resourcename }}"
+      }
+    }
+  ],
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccBarGaugeDataSourceCloudMonitoringConfig = `
+data "gdashboard_bar_gauge" "test" {
+  title = "Test"
+
+  queries {
+    google_cloud_monitoring {
+      uid    = "stackdriver"
+      ref_id = "GCM_Query"
+
+      builder {
+        project          = "my-project"
+        metric_type      = "compute.googleapis.com/instance/cpu/utilization"
+        reducer          = "REDUCE_MEAN"
+        alignment_period = "+60s"
+        group_by         = ["resource.label.zone"]
+        alias            = "{{resource.label.zone}}"
+
+        filter {
+          key      = "resource.label.zone"
+          operator = "=~"
+          value    = "europe-.*"
+        }
+
+        filter {
+          key   = "metadata.system_labels.state"
+          value = "RUNNING"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccBarGaugeDataSourceCloudMonitoringConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "bargauge",
+  "options": {
+    "orientation": "auto",
+    "textMode": "",
+    "colorMode": "",
+    "graphMode": "",
+    "justifyMode": "",
+    "displayMode": "gradient",
+    "content": "",
+    "mode": "",
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "targets": [
+    {
+      "refId": "GCM_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "stackdriver",
+        "name": "",
+        "type": "stackdriver",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "timeSeriesList",
+      "aliasBy": "{{resource.label.zone}}",
+      "timeSeriesList": {
+        "projectName": "my-project",
+        "filters": [
+          "metric.type",
+          "=",
+          "compute.googleapis.com/instance/cpu/utilization",
+          "AND",
+          "resource.label.zone",
+          "=~",
+          "europe-.*",
+          "AND",
+          "metadata.system_labels.state",
+          "=",
+          "RUNNING"
+        ],
+        "perSeriesAligner": "ALIGN_MEAN",
+        "crossSeriesReducer": "REDUCE_MEAN",
+        "alignmentPeriod": "+60s",
+        "groupBys": [
+          "resource.label.zone"
+        ]
+      }
+    }
+  ],
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
 const testAccBarGaugeDataSourceProviderCustomDefaultsConfig = `
 provider "gdashboard" {
   defaults {
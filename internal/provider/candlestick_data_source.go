@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CandlestickDataSource{}
+
+func NewCandlestickDataSource() datasource.DataSource {
+	return &CandlestickDataSource{}
+}
+
+// CandlestickDataSource defines the data source implementation.
+type CandlestickDataSource struct {
+	CompactJson bool
+	Defaults    CandlestickDefaults
+}
+
+type CandlestickDefaults struct {
+	Legend  TimeseriesLegendDefault
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Axis    AxisDefaults
+	Graph   CandlestickGraphDefaults
+}
+
+type CandlestickGraphDefaults struct {
+	Mode             string
+	CandleStyle      string
+	ColorStrategy    string
+	UpColor          string
+	DownColor        string
+	IncludeAllFields bool
+}
+
+// CandlestickDataSourceModel describes the data source data model.
+type CandlestickDataSourceModel struct {
+	Id              types.String               `tfsdk:"id"`
+	Json            types.String               `tfsdk:"json"`
+	CompactJson     types.Bool                 `tfsdk:"compact_json"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Queries         []Query                    `tfsdk:"queries"`
+	Legend          []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field           []FieldOptions             `tfsdk:"field"`
+	Axis            []AxisOptions              `tfsdk:"axis"`
+	Graph           []CandlestickGraphOptions  `tfsdk:"graph"`
+	Fields          []CandlestickFieldsOptions `tfsdk:"fields"`
+	Overrides       []FieldOverrideOptions     `tfsdk:"overrides"`
+	Transformations []Transformations          `tfsdk:"transform"`
+}
+
+type CandlestickGraphOptions struct {
+	Mode             types.String `tfsdk:"mode"`
+	CandleStyle      types.String `tfsdk:"candle_style"`
+	ColorStrategy    types.String `tfsdk:"color_strategy"`
+	UpColor          types.String `tfsdk:"up_color"`
+	DownColor        types.String `tfsdk:"down_color"`
+	IncludeAllFields types.Bool   `tfsdk:"include_all_fields"`
+}
+
+type CandlestickFieldsOptions struct {
+	Open   types.String `tfsdk:"open"`
+	High   types.String `tfsdk:"high"`
+	Low    types.String `tfsdk:"low"`
+	Close  types.String `tfsdk:"close"`
+	Volume types.String `tfsdk:"volume"`
+}
+
+func (d *CandlestickDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_candlestick"
+}
+
+func candlestickGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose which data to display. The choices are: candles, volume, both.",
+					MarkdownDescription: "Choose which data to display. The choices are: `candles`, `volume`, `both`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("candles", "volume", "both"),
+					},
+				},
+				"candle_style": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The style of the candles. The choices are: candles, ohlc_bars.",
+					MarkdownDescription: "The style of the candles. The choices are: `candles`, `ohlc_bars`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("candles", "ohlc_bars"),
+					},
+				},
+				"color_strategy": schema.StringAttribute{
+					Optional: true,
+					Description: "Choose how to color the candles. The choices are: open_close, close_close. " +
+						"In the open_close mode, the color depends on the open and close values of the same period. " +
+						"In the close_close mode, the color depends on the close values of the current and the previous periods.",
+					MarkdownDescription: "Choose how to color the candles. The choices are: `open_close`, `close_close`. " +
+						"In the `open_close` mode, the color depends on the open and close values of the same period. " +
+						"In the `close_close` mode, the color depends on the close values of the current and the previous periods.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("open_close", "close_close"),
+					},
+				},
+				"up_color": schema.StringAttribute{
+					Optional:    true,
+					Description: "The color of the candles when the price goes up.",
+				},
+				"down_color": schema.StringAttribute{
+					Optional:    true,
+					Description: "The color of the candles when the price goes down.",
+				},
+				"include_all_fields": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to display the fields that are not mapped to the candles or volume or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func candlestickFieldsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The mapping of the fields to the candles and volume. By default, the fields are detected by name.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"open": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field with the opening value of the period.",
+				},
+				"high": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field with the highest value of the period.",
+				},
+				"low": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field with the lowest value of the period.",
+				},
+				"close": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field with the closing value of the period.",
+				},
+				"volume": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field with the volume of the period.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *CandlestickDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Candlestick panel data source.",
+		MarkdownDescription: "Candlestick panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/candlestick/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    timeseriesLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"axis":      axisBlock(),
+			"graph":     candlestickGraphBlock(),
+			"fields":    candlestickFieldsBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *CandlestickDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.Candlestick
+}
+
+func (d *CandlestickDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CandlestickDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       d.Defaults.Legend.Calculations,
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if len(legend.Calculations) > 0 {
+			calculations := make([]string, len(legend.Calculations))
+			for i, calc := range legend.Calculations {
+				calculations[i] = calc.ValueString()
+			}
+
+			legendOptions.Calcs = calculations
+		}
+
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	graphOptions := d.Defaults.Graph
+
+	for _, graph := range data.Graph {
+		updateCandlestickGraphDefaults(&graphOptions, graph)
+	}
+
+	options := grafana.CandlestickOptions{
+		Mode:          candlestickMode(graphOptions.Mode),
+		CandleStyle:   candlestickCandleStyle(graphOptions.CandleStyle),
+		ColorStrategy: candlestickColorStrategy(graphOptions.ColorStrategy),
+		Colors: grafana.CandlestickColors{
+			Up:   graphOptions.UpColor,
+			Down: graphOptions.DownColor,
+		},
+		IncludeAllFields: graphOptions.IncludeAllFields,
+		Legend:           legendOptions,
+		Tooltip:          tooltipOptions,
+	}
+
+	for _, fields := range data.Fields {
+		options.Fields = grafana.CandlestickFieldMap{
+			Open:   fields.Open.ValueString(),
+			High:   fields.High.ValueString(),
+			Low:    fields.Low.ValueString(),
+			Close:  fields.Close.ValueString(),
+			Volume: fields.Volume.ValueString(),
+		}
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		DrawStyle:         "line",
+		LineInterpolation: "linear",
+		LineWidth:         1,
+		FillOpacity:       0,
+		GradientMode:      "none",
+		ShowPoints:        "auto",
+		PointSize:         5,
+		// axis
+		AxisLabel:     d.Defaults.Axis.Label,
+		AxisPlacement: d.Defaults.Axis.Placement,
+		AxisSoftMin:   d.Defaults.Axis.SoftMin,
+		AxisSoftMax:   d.Defaults.Axis.SoftMax,
+	}
+
+	fieldConfig.Custom.LineStyle.Fill = "solid"
+	fieldConfig.Custom.ScaleDistribution.Type = d.Defaults.Axis.Scale.Type
+	fieldConfig.Custom.ScaleDistribution.Log = d.Defaults.Axis.Scale.Log
+
+	updateAxis(&fieldConfig.Custom, data.Axis)
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.CandlestickType,
+			Title:           data.Title.ValueString(),
+			Type:            "candlestick",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		CandlestickPanel: &grafana.CandlestickPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func updateCandlestickGraphDefaults(defaults *CandlestickGraphDefaults, graph CandlestickGraphOptions) {
+	if !graph.Mode.IsNull() {
+		defaults.Mode = graph.Mode.ValueString()
+	}
+
+	if !graph.CandleStyle.IsNull() {
+		defaults.CandleStyle = graph.CandleStyle.ValueString()
+	}
+
+	if !graph.ColorStrategy.IsNull() {
+		defaults.ColorStrategy = graph.ColorStrategy.ValueString()
+	}
+
+	if !graph.UpColor.IsNull() {
+		defaults.UpColor = graph.UpColor.ValueString()
+	}
+
+	if !graph.DownColor.IsNull() {
+		defaults.DownColor = graph.DownColor.ValueString()
+	}
+
+	if !graph.IncludeAllFields.IsNull() {
+		defaults.IncludeAllFields = graph.IncludeAllFields.ValueBool()
+	}
+}
+
+func candlestickMode(mode string) string {
+	if mode == "both" {
+		return "candles+volume"
+	}
+
+	return mode
+}
+
+func candlestickCandleStyle(style string) string {
+	if style == "ohlc_bars" {
+		return "ohlcbars"
+	}
+
+	return style
+}
+
+func candlestickColorStrategy(strategy string) string {
+	switch strategy {
+	case "open_close":
+		return "open-close"
+	case "close_close":
+		return "close-close"
+	}
+
+	return strategy
+}
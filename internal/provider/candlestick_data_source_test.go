@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCandlestickDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCandlestickDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "json", testAccCandlestickDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccCandlestickDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "json", testAccCandlestickDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccCandlestickDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_candlestick.test", "json", testAccCandlestickDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccCandlestickDataSourceConfig = `
+data "gdashboard_candlestick" "test" {
+  title       = "Test"
+  description = "Candlestick description"
+
+  legend {
+    calculations = ["last"]
+    display_mode = "table"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit = "currencyUSD"
+  }
+
+  axis {
+    label     = "Price"
+    placement = "right"
+  }
+
+  graph {
+    mode               = "candles"
+    candle_style       = "ohlc_bars"
+    color_strategy     = "close_close"
+    up_color           = "blue"
+    down_color         = "orange"
+    include_all_fields = true
+  }
+
+  fields {
+    open   = "open_price"
+    high   = "high_price"
+    low    = "low_price"
+    close  = "close_price"
+    volume = "trades"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "stock_price"
+      ref_id = "Prometheus_Query"
+    }
+  }
+}
+`
+
+const testAccCandlestickDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Candlestick description",
+  "transparent": false,
+  "type": "candlestick",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "stock_price"
+    }
+  ],
+  "options": {
+    "mode": "candles",
+    "candleStyle": "ohlcbars",
+    "colorStrategy": "close-close",
+    "colors": {
+      "up": "blue",
+      "down": "orange"
+    },
+    "fields": {
+      "open": "open_price",
+      "high": "high_price",
+      "low": "low_price",
+      "close": "close_price",
+      "volume": "trades"
+    },
+    "includeAllFields": true,
+    "legend": {
+      "calcs": [
+        "last"
+      ],
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "currencyUSD",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisLabel": "Price",
+        "axisPlacement": "right",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccCandlestickDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    candlestick {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "currencyEUR"
+      }
+
+      axis {
+        placement = "left"
+      }
+
+      graph {
+        mode       = "volume"
+        up_color   = "dark-green"
+        down_color = "dark-red"
+      }
+    }
+  }
+}
+
+data "gdashboard_candlestick" "test" {
+  title = "Test"
+}
+`
+
+const testAccCandlestickDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "candlestick",
+  "options": {
+    "mode": "volume",
+    "candleStyle": "candles",
+    "colorStrategy": "open-close",
+    "colors": {
+      "up": "dark-green",
+      "down": "dark-red"
+    },
+    "fields": {},
+    "includeAllFields": false,
+    "legend": {
+      "calcs": [],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "currencyEUR",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "left",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccCandlestickDataSourceProviderDefaultsConfig = `
+data "gdashboard_candlestick" "test" {
+  title = "Test"
+}
+`
+
+const testAccCandlestickDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "candlestick",
+  "options": {
+    "mode": "candles+volume",
+    "candleStyle": "candles",
+    "colorStrategy": "open-close",
+    "colors": {
+      "up": "green",
+      "down": "red"
+    },
+    "fields": {},
+    "includeAllFields": false,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -0,0 +1,879 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CanvasDataSource{}
+
+func NewCanvasDataSource() datasource.DataSource {
+	return &CanvasDataSource{}
+}
+
+// CanvasDataSource defines the data source implementation.
+type CanvasDataSource struct {
+	CompactJson bool
+	Defaults    CanvasDefaults
+}
+
+type CanvasDefaults struct {
+	Field FieldDefaults
+	Graph CanvasGraphDefaults
+}
+
+type CanvasGraphDefaults struct {
+	InlineEditing     bool
+	ShowAdvancedTypes bool
+	PanZoom           bool
+}
+
+// CanvasDataSourceModel describes the data source data model.
+type CanvasDataSourceModel struct {
+	Id              types.String           `tfsdk:"id"`
+	Json            types.String           `tfsdk:"json"`
+	CompactJson     types.Bool             `tfsdk:"compact_json"`
+	Title           types.String           `tfsdk:"title"`
+	Description     types.String           `tfsdk:"description"`
+	Queries         []Query                `tfsdk:"queries"`
+	Field           []FieldOptions         `tfsdk:"field"`
+	Graph           []CanvasGraphOptions   `tfsdk:"graph"`
+	Elements        []CanvasElementOptions `tfsdk:"element"`
+	Overrides       []FieldOverrideOptions `tfsdk:"overrides"`
+	Transformations []Transformations      `tfsdk:"transform"`
+}
+
+type CanvasGraphOptions struct {
+	InlineEditing     types.Bool `tfsdk:"inline_editing"`
+	ShowAdvancedTypes types.Bool `tfsdk:"show_advanced_types"`
+	PanZoom           types.Bool `tfsdk:"pan_zoom"`
+}
+
+type CanvasElementOptions struct {
+	Type        types.String              `tfsdk:"type"`
+	Name        types.String              `tfsdk:"name"`
+	Placement   []CanvasPlacementOptions  `tfsdk:"placement"`
+	Background  []CanvasBackgroundOptions `tfsdk:"background"`
+	Border      []CanvasBorderOptions     `tfsdk:"border"`
+	Text        []CanvasTextOptions       `tfsdk:"text"`
+	Icon        []CanvasIconOptions       `tfsdk:"icon"`
+	Server      []CanvasServerOptions     `tfsdk:"server"`
+	Connections []CanvasConnectionOptions `tfsdk:"connection"`
+}
+
+type CanvasPlacementOptions struct {
+	Top      types.Float64 `tfsdk:"top"`
+	Left     types.Float64 `tfsdk:"left"`
+	Width    types.Float64 `tfsdk:"width"`
+	Height   types.Float64 `tfsdk:"height"`
+	Rotation types.Float64 `tfsdk:"rotation"`
+}
+
+type CanvasBackgroundOptions struct {
+	Color      types.String `tfsdk:"color"`
+	ColorField types.String `tfsdk:"color_field"`
+	Image      types.String `tfsdk:"image"`
+	ImageField types.String `tfsdk:"image_field"`
+	ImageSize  types.String `tfsdk:"image_size"`
+}
+
+type CanvasBorderOptions struct {
+	Color      types.String `tfsdk:"color"`
+	ColorField types.String `tfsdk:"color_field"`
+	Width      types.Int64  `tfsdk:"width"`
+	Radius     types.Int64  `tfsdk:"radius"`
+}
+
+type CanvasTextOptions struct {
+	Value         types.String `tfsdk:"value"`
+	Field         types.String `tfsdk:"field"`
+	Color         types.String `tfsdk:"color"`
+	ColorField    types.String `tfsdk:"color_field"`
+	Size          types.Int64  `tfsdk:"size"`
+	Align         types.String `tfsdk:"align"`
+	VerticalAlign types.String `tfsdk:"vertical_align"`
+}
+
+type CanvasIconOptions struct {
+	Path      types.String `tfsdk:"path"`
+	Fill      types.String `tfsdk:"fill"`
+	FillField types.String `tfsdk:"fill_field"`
+}
+
+type CanvasServerOptions struct {
+	Type           types.String  `tfsdk:"type"`
+	BulbColor      types.String  `tfsdk:"bulb_color"`
+	BulbColorField types.String  `tfsdk:"bulb_color_field"`
+	BlinkRate      types.Float64 `tfsdk:"blink_rate"`
+}
+
+type CanvasConnectionOptions struct {
+	Target  types.String  `tfsdk:"target"`
+	SourceX types.Float64 `tfsdk:"source_x"`
+	SourceY types.Float64 `tfsdk:"source_y"`
+	TargetX types.Float64 `tfsdk:"target_x"`
+	TargetY types.Float64 `tfsdk:"target_y"`
+	Color   types.String  `tfsdk:"color"`
+	Width   types.Float64 `tfsdk:"width"`
+}
+
+func (d *CanvasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canvas"
+}
+
+func canvasGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"inline_editing": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to allow editing the elements directly on the dashboard or not. Defaults to true.",
+				},
+				"show_advanced_types": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the advanced element types in the editor or not. Defaults to true.",
+				},
+				"pan_zoom": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to allow panning and zooming of the canvas or not. Defaults to false.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func canvasCoordinateAttribute(description string) schema.Attribute {
+	return schema.Float64Attribute{
+		Optional:            true,
+		Description:         description + " Must be between -1 and 1 (inclusive), where 0 is the center of the element.",
+		MarkdownDescription: description + " Must be between `-1` and `1` (inclusive), where `0` is the center of the element.",
+		Validators: []validator.Float64{
+			float64validator.Between(-1, 1),
+		},
+	}
+}
+
+func canvasElementBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The elements of the canvas. The elements are rendered in the order of definition.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"placement": schema.ListNestedBlock{
+					Description: "The position and the size of the element in pixels.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"top": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The offset from the top edge of the canvas.",
+							},
+							"left": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The offset from the left edge of the canvas.",
+							},
+							"width": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The width of the element.",
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+							"height": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The height of the element.",
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+							"rotation": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The rotation of the element in degrees.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"background": schema.ListNestedBlock{
+					Description: "The background of the element.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed background color.",
+							},
+							"color_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to derive the background color from.",
+							},
+							"image": schema.StringAttribute{
+								Optional:    true,
+								Description: "The URL of the fixed background image.",
+							},
+							"image_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field with the URL of the background image.",
+							},
+							"image_size": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The size of the background image. The choices are: original, contain, cover, fill, tile.",
+								MarkdownDescription: "The size of the background image. The choices are: `original`, `contain`, `cover`, `fill`, `tile`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("original", "contain", "cover", "fill", "tile"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"border": schema.ListNestedBlock{
+					Description: "The border of the element.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed border color.",
+							},
+							"color_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to derive the border color from.",
+							},
+							"width": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The width of the border in pixels.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"radius": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The radius of the border corners in pixels.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"text": schema.ListNestedBlock{
+					Description:         "The text of the element. Used with the metric_value, text, rectangle and ellipse elements.",
+					MarkdownDescription: "The text of the element. Used with the `metric_value`, `text`, `rectangle` and `ellipse` elements.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"value": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed text.",
+							},
+							"field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to display the value of.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed text color.",
+							},
+							"color_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to derive the text color from.",
+							},
+							"size": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The font size in pixels.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"align": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The horizontal alignment of the text. The choices are: left, center, right.",
+								MarkdownDescription: "The horizontal alignment of the text. The choices are: `left`, `center`, `right`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("left", "center", "right"),
+								},
+							},
+							"vertical_align": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The vertical alignment of the text. The choices are: top, middle, bottom.",
+								MarkdownDescription: "The vertical alignment of the text. The choices are: `top`, `middle`, `bottom`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("top", "middle", "bottom"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"icon": schema.ListNestedBlock{
+					Description:         "The icon options. Used with the icon element.",
+					MarkdownDescription: "The icon options. Used with the `icon` element.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"path": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The path of the SVG icon. For example: img/icons/unicons/cloud.svg.",
+								MarkdownDescription: "The path of the SVG icon. For example: `img/icons/unicons/cloud.svg`.",
+							},
+							"fill": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed fill color of the icon.",
+							},
+							"fill_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to derive the fill color of the icon from.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"server": schema.ListNestedBlock{
+					Description:         "The server options. Used with the server element.",
+					MarkdownDescription: "The server options. Used with the `server` element.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The type of the server. The choices are: single, stack, database, terminal.",
+								MarkdownDescription: "The type of the server. The choices are: `single`, `stack`, `database`, `terminal`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("single", "stack", "database", "terminal"),
+								},
+							},
+							"bulb_color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed color of the status bulb.",
+							},
+							"bulb_color_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to derive the color of the status bulb from.",
+							},
+							"blink_rate": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The blink rate of the status bulb in blinks per second.",
+								Validators: []validator.Float64{
+									float64validator.AtLeast(0),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"connection": schema.ListNestedBlock{
+					Description: "The connections from the element to other elements.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"target": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the target element.",
+							},
+							"source_x": canvasCoordinateAttribute("The horizontal position of the connection on the source element."),
+							"source_y": canvasCoordinateAttribute("The vertical position of the connection on the source element."),
+							"target_x": canvasCoordinateAttribute("The horizontal position of the connection on the target element."),
+							"target_y": canvasCoordinateAttribute("The vertical position of the connection on the target element."),
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The color of the connection.",
+							},
+							"width": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The width of the connection in pixels.",
+								Validators: []validator.Float64{
+									float64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					Description:         "The type of the element. The choices are: metric_value, text, rectangle, ellipse, icon, server.",
+					MarkdownDescription: "The type of the element. The choices are: `metric_value`, `text`, `rectangle`, `ellipse`, `icon`, `server`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("metric_value", "text", "rectangle", "ellipse", "icon", "server"),
+					},
+				},
+				"name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the element. Used as the target of the connections. By default, the name is generated from the position of the element.",
+				},
+			},
+		},
+	}
+}
+
+func (d *CanvasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Canvas panel data source.",
+		MarkdownDescription: "Canvas panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/canvas/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"field":     fieldBlock(false),
+			"graph":     canvasGraphBlock(),
+			"element":   canvasElementBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *CanvasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.Canvas
+}
+
+func (d *CanvasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CanvasDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+	transformations := createTransformations(data.Transformations)
+
+	graphOptions := d.Defaults.Graph
+
+	for _, graph := range data.Graph {
+		updateCanvasGraphDefaults(&graphOptions, graph)
+	}
+
+	options := grafana.CanvasOptions{
+		InlineEditing:     graphOptions.InlineEditing,
+		ShowAdvancedTypes: graphOptions.ShowAdvancedTypes,
+		PanZoom:           graphOptions.PanZoom,
+		Root: grafana.CanvasFrame{
+			Type:     "frame",
+			Name:     "Element 0",
+			Elements: make([]grafana.CanvasElement, len(data.Elements)),
+			Background: grafana.CanvasBackground{
+				Color: grafana.ColorDimension{
+					Fixed: "transparent",
+				},
+			},
+			Border: grafana.CanvasBorder{
+				Color: grafana.ColorDimension{
+					Fixed: "dark-green",
+				},
+			},
+			Constraint: grafana.CanvasConstraint{
+				Horizontal: "left",
+				Vertical:   "top",
+			},
+		},
+	}
+
+	for i, element := range data.Elements {
+		options.Root.Elements[i] = createCanvasElement(element, i+1)
+	}
+
+	elementNames := make(map[string]bool, len(options.Root.Elements))
+	for _, element := range options.Root.Elements {
+		elementNames[element.Name] = true
+	}
+
+	for i, element := range options.Root.Elements {
+		for j, connection := range element.Connections {
+			if !elementNames[connection.TargetName] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("element").AtListIndex(i).AtName("connection").AtListIndex(j).AtName("target"),
+					"Unknown Connection Target",
+					fmt.Sprintf("The connection of the element %q targets the element %q, but there is no element with this name.", element.Name, connection.TargetName),
+				)
+			}
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.CanvasType,
+			Title:           data.Title.ValueString(),
+			Type:            "canvas",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		CanvasPanel: &grafana.CanvasPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func updateCanvasGraphDefaults(defaults *CanvasGraphDefaults, graph CanvasGraphOptions) {
+	if !graph.InlineEditing.IsNull() {
+		defaults.InlineEditing = graph.InlineEditing.ValueBool()
+	}
+
+	if !graph.ShowAdvancedTypes.IsNull() {
+		defaults.ShowAdvancedTypes = graph.ShowAdvancedTypes.ValueBool()
+	}
+
+	if !graph.PanZoom.IsNull() {
+		defaults.PanZoom = graph.PanZoom.ValueBool()
+	}
+}
+
+func createCanvasElement(opts CanvasElementOptions, index int) grafana.CanvasElement {
+	elementType := opts.Type.ValueString()
+
+	element := grafana.CanvasElement{
+		Type: elementType,
+		Name: fmt.Sprintf("Element %d", index),
+		Background: grafana.CanvasBackground{
+			Color: grafana.ColorDimension{
+				Fixed: "#D9D9D9",
+			},
+		},
+		Border: grafana.CanvasBorder{
+			Color: grafana.ColorDimension{
+				Fixed: "dark-green",
+			},
+		},
+		Placement: grafana.CanvasPlacement{
+			Width:  240,
+			Height: 160,
+		},
+		Constraint: grafana.CanvasConstraint{
+			Horizontal: "left",
+			Vertical:   "top",
+		},
+	}
+
+	if !opts.Name.IsNull() {
+		element.Name = opts.Name.ValueString()
+	}
+
+	switch elementType {
+	case "metric_value", "text", "rectangle", "ellipse":
+		text := &grafana.TextDimension{
+			Mode: "fixed",
+		}
+
+		if elementType == "metric_value" {
+			element.Type = "metric-value"
+			element.Placement.Width = 260
+			element.Placement.Height = 50
+			text.Mode = "field"
+		}
+
+		if elementType == "text" {
+			element.Background.Color.Fixed = "transparent"
+			element.Placement.Width = 260
+			element.Placement.Height = 50
+		}
+
+		element.Config = grafana.CanvasElementConfig{
+			Text: text,
+			Color: &grafana.ColorDimension{
+				Fixed: "#000000",
+			},
+			Size:   16,
+			Align:  "center",
+			VAlign: "middle",
+		}
+
+		for _, t := range opts.Text {
+			if !t.Value.IsNull() {
+				text.Mode = "fixed"
+				text.Fixed = t.Value.ValueString()
+			}
+
+			if !t.Field.IsNull() {
+				text.Mode = "field"
+				text.Field = t.Field.ValueString()
+			}
+
+			if !t.Color.IsNull() {
+				element.Config.Color.Fixed = t.Color.ValueString()
+			}
+
+			if !t.ColorField.IsNull() {
+				element.Config.Color.Field = t.ColorField.ValueString()
+			}
+
+			if !t.Size.IsNull() {
+				element.Config.Size = t.Size.ValueInt64()
+			}
+
+			if !t.Align.IsNull() {
+				element.Config.Align = t.Align.ValueString()
+			}
+
+			if !t.VerticalAlign.IsNull() {
+				element.Config.VAlign = t.VerticalAlign.ValueString()
+			}
+		}
+	case "icon":
+		element.Background.Color.Fixed = "transparent"
+		element.Placement.Width = 100
+		element.Placement.Height = 100
+
+		element.Config = grafana.CanvasElementConfig{
+			Path: &grafana.ResourceDimension{
+				Mode:  "fixed",
+				Fixed: "img/icons/unicons/question-circle.svg",
+			},
+			Fill: &grafana.ColorDimension{
+				Fixed: "#000000",
+			},
+		}
+
+		for _, icon := range opts.Icon {
+			if !icon.Path.IsNull() {
+				element.Config.Path.Fixed = icon.Path.ValueString()
+			}
+
+			if !icon.Fill.IsNull() {
+				element.Config.Fill.Fixed = icon.Fill.ValueString()
+			}
+
+			if !icon.FillField.IsNull() {
+				element.Config.Fill.Field = icon.FillField.ValueString()
+			}
+		}
+	case "server":
+		element.Background.Color.Fixed = "transparent"
+		element.Placement.Width = 100
+		element.Placement.Height = 100
+
+		element.Config = grafana.CanvasElementConfig{
+			Type: "single",
+			BulbColor: &grafana.ColorDimension{
+				Fixed: "green",
+			},
+		}
+
+		for _, server := range opts.Server {
+			if !server.Type.IsNull() {
+				element.Config.Type = server.Type.ValueString()
+			}
+
+			if !server.BulbColor.IsNull() {
+				element.Config.BulbColor.Fixed = server.BulbColor.ValueString()
+			}
+
+			if !server.BulbColorField.IsNull() {
+				element.Config.BulbColor.Field = server.BulbColorField.ValueString()
+			}
+
+			if !server.BlinkRate.IsNull() {
+				element.Config.BlinkRate = &grafana.ScalarDimension{
+					Fixed: server.BlinkRate.ValueFloat64(),
+					Min:   0,
+					Max:   100,
+				}
+			}
+		}
+	}
+
+	for _, placement := range opts.Placement {
+		if !placement.Top.IsNull() {
+			element.Placement.Top = placement.Top.ValueFloat64()
+		}
+
+		if !placement.Left.IsNull() {
+			element.Placement.Left = placement.Left.ValueFloat64()
+		}
+
+		if !placement.Width.IsNull() {
+			element.Placement.Width = placement.Width.ValueFloat64()
+		}
+
+		if !placement.Height.IsNull() {
+			element.Placement.Height = placement.Height.ValueFloat64()
+		}
+
+		if !placement.Rotation.IsNull() {
+			element.Placement.Rotation = placement.Rotation.ValueFloat64()
+		}
+	}
+
+	for _, background := range opts.Background {
+		if !background.Color.IsNull() {
+			element.Background.Color.Fixed = background.Color.ValueString()
+		}
+
+		if !background.ColorField.IsNull() {
+			element.Background.Color.Field = background.ColorField.ValueString()
+		}
+
+		if !background.Image.IsNull() {
+			element.Background.Image = &grafana.ResourceDimension{
+				Mode:  "fixed",
+				Fixed: background.Image.ValueString(),
+			}
+		}
+
+		if !background.ImageField.IsNull() {
+			element.Background.Image = &grafana.ResourceDimension{
+				Mode:  "field",
+				Fixed: background.Image.ValueString(),
+				Field: background.ImageField.ValueString(),
+			}
+		}
+
+		if !background.ImageSize.IsNull() {
+			element.Background.Size = background.ImageSize.ValueString()
+		}
+	}
+
+	for _, border := range opts.Border {
+		if !border.Color.IsNull() {
+			element.Border.Color.Fixed = border.Color.ValueString()
+		}
+
+		if !border.ColorField.IsNull() {
+			element.Border.Color.Field = border.ColorField.ValueString()
+		}
+
+		if !border.Width.IsNull() {
+			element.Border.Width = border.Width.ValueInt64()
+		}
+
+		if !border.Radius.IsNull() {
+			element.Border.Radius = border.Radius.ValueInt64()
+		}
+	}
+
+	for _, connection := range opts.Connections {
+		c := grafana.CanvasConnection{
+			Source: grafana.CanvasConnectionCoordinates{
+				X: 1,
+				Y: 0,
+			},
+			Target: grafana.CanvasConnectionCoordinates{
+				X: -1,
+				Y: 0,
+			},
+			TargetName: connection.Target.ValueString(),
+			Color: grafana.ColorDimension{
+				Fixed: "white",
+			},
+			Size: grafana.ScaleDimension{
+				Fixed: 2,
+				Min:   1,
+				Max:   10,
+			},
+			Path: "straight",
+		}
+
+		if !connection.SourceX.IsNull() {
+			c.Source.X = connection.SourceX.ValueFloat64()
+		}
+
+		if !connection.SourceY.IsNull() {
+			c.Source.Y = connection.SourceY.ValueFloat64()
+		}
+
+		if !connection.TargetX.IsNull() {
+			c.Target.X = connection.TargetX.ValueFloat64()
+		}
+
+		if !connection.TargetY.IsNull() {
+			c.Target.Y = connection.TargetY.ValueFloat64()
+		}
+
+		if !connection.Color.IsNull() {
+			c.Color.Fixed = connection.Color.ValueString()
+		}
+
+		if !connection.Width.IsNull() {
+			c.Size.Fixed = connection.Width.ValueFloat64()
+		}
+
+		element.Connections = append(element.Connections, c)
+	}
+
+	return element
+}
@@ -0,0 +1,725 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCanvasDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCanvasDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "json", testAccCanvasDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccCanvasDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "json", testAccCanvasDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccCanvasDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_canvas.test", "json", testAccCanvasDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccCanvasDataSourceUnknownConnectionTargetConfig,
+				ExpectError: regexp.MustCompile("targets the element \"Database\", but there is no element with this name"),
+			},
+		},
+	})
+}
+
+const testAccCanvasDataSourceUnknownConnectionTargetConfig = `
+data "gdashboard_canvas" "test" {
+  title = "Test"
+
+  element {
+    type = "text"
+    name = "Gateway"
+
+    connection {
+      target = "Database"
+    }
+  }
+
+  element {
+    type = "text"
+    name = "Cache"
+  }
+}
+`
+
+const testAccCanvasDataSourceConfig = `
+data "gdashboard_canvas" "test" {
+  title       = "Test"
+  description = "Canvas description"
+
+  field {
+    unit = "reqps"
+  }
+
+  graph {
+    inline_editing      = false
+    show_advanced_types = false
+    pan_zoom            = true
+  }
+
+  element {
+    type = "metric_value"
+    name = "RPS"
+
+    placement {
+      top    = 10
+      left   = 20
+      width  = 200
+      height = 40
+    }
+
+    background {
+      color_field = "Value"
+    }
+
+    border {
+      color  = "blue"
+      width  = 2
+      radius = 4
+    }
+
+    text {
+      field          = "Value"
+      color          = "white"
+      size           = 24
+      align          = "left"
+      vertical_align = "top"
+    }
+
+    connection {
+      target   = "Database"
+      source_y = 0.5
+      color    = "red"
+      width    = 3
+    }
+  }
+
+  element {
+    type = "text"
+
+    text {
+      value = "Gateway"
+    }
+  }
+
+  element {
+    type = "rectangle"
+
+    background {
+      image      = "https://example.com/background.png"
+      image_size = "cover"
+    }
+  }
+
+  element {
+    type = "ellipse"
+
+    placement {
+      rotation = 45
+    }
+  }
+
+  element {
+    type = "icon"
+
+    icon {
+      path       = "img/icons/unicons/cloud.svg"
+      fill_field = "Value"
+    }
+  }
+
+  element {
+    type = "server"
+    name = "Database"
+
+    server {
+      type       = "database"
+      bulb_color = "red"
+      blink_rate = 2
+    }
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum(rate(http_requests_total[$__rate_interval]))"
+      ref_id  = "Prometheus_Query"
+      instant = true
+    }
+  }
+}
+`
+
+const testAccCanvasDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Canvas description",
+  "transparent": false,
+  "type": "canvas",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum(rate(http_requests_total[$__rate_interval]))",
+      "instant": true
+    }
+  ],
+  "options": {
+    "inlineEditing": false,
+    "showAdvancedTypes": false,
+    "panZoom": true,
+    "root": {
+      "type": "frame",
+      "name": "Element 0",
+      "elements": [
+        {
+          "type": "metric-value",
+          "name": "RPS",
+          "config": {
+            "text": {
+              "mode": "field",
+              "fixed": "",
+              "field": "Value"
+            },
+            "color": {
+              "fixed": "white"
+            },
+            "size": 24,
+            "align": "left",
+            "valign": "top"
+          },
+          "background": {
+            "color": {
+              "fixed": "#D9D9D9",
+              "field": "Value"
+            }
+          },
+          "border": {
+            "color": {
+              "fixed": "blue"
+            },
+            "width": 2,
+            "radius": 4
+          },
+          "placement": {
+            "top": 10,
+            "left": 20,
+            "width": 200,
+            "height": 40,
+            "rotation": 0
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          },
+          "connections": [
+            {
+              "source": {
+                "x": 1,
+                "y": 0.5
+              },
+              "target": {
+                "x": -1,
+                "y": 0
+              },
+              "targetName": "Database",
+              "color": {
+                "fixed": "red"
+              },
+              "size": {
+                "fixed": 3,
+                "min": 1,
+                "max": 10
+              },
+              "path": "straight"
+            }
+          ]
+        },
+        {
+          "type": "text",
+          "name": "Element 2",
+          "config": {
+            "text": {
+              "mode": "fixed",
+              "fixed": "Gateway"
+            },
+            "color": {
+              "fixed": "#000000"
+            },
+            "size": 16,
+            "align": "center",
+            "valign": "middle"
+          },
+          "background": {
+            "color": {
+              "fixed": "transparent"
+            }
+          },
+          "border": {
+            "color": {
+              "fixed": "dark-green"
+            }
+          },
+          "placement": {
+            "top": 0,
+            "left": 0,
+            "width": 260,
+            "height": 50,
+            "rotation": 0
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          }
+        },
+        {
+          "type": "rectangle",
+          "name": "Element 3",
+          "config": {
+            "text": {
+              "mode": "fixed",
+              "fixed": ""
+            },
+            "color": {
+              "fixed": "#000000"
+            },
+            "size": 16,
+            "align": "center",
+            "valign": "middle"
+          },
+          "background": {
+            "color": {
+              "fixed": "#D9D9D9"
+            },
+            "image": {
+              "mode": "fixed",
+              "fixed": "https://example.com/background.png"
+            },
+            "size": "cover"
+          },
+          "border": {
+            "color": {
+              "fixed": "dark-green"
+            }
+          },
+          "placement": {
+            "top": 0,
+            "left": 0,
+            "width": 240,
+            "height": 160,
+            "rotation": 0
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          }
+        },
+        {
+          "type": "ellipse",
+          "name": "Element 4",
+          "config": {
+            "text": {
+              "mode": "fixed",
+              "fixed": ""
+            },
+            "color": {
+              "fixed": "#000000"
+            },
+            "size": 16,
+            "align": "center",
+            "valign": "middle"
+          },
+          "background": {
+            "color": {
+              "fixed": "#D9D9D9"
+            }
+          },
+          "border": {
+            "color": {
+              "fixed": "dark-green"
+            }
+          },
+          "placement": {
+            "top": 0,
+            "left": 0,
+            "width": 240,
+            "height": 160,
+            "rotation": 45
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          }
+        },
+        {
+          "type": "icon",
+          "name": "Element 5",
+          "config": {
+            "path": {
+              "mode": "fixed",
+              "fixed": "img/icons/unicons/cloud.svg"
+            },
+            "fill": {
+              "fixed": "#000000",
+              "field": "Value"
+            }
+          },
+          "background": {
+            "color": {
+              "fixed": "transparent"
+            }
+          },
+          "border": {
+            "color": {
+              "fixed": "dark-green"
+            }
+          },
+          "placement": {
+            "top": 0,
+            "left": 0,
+            "width": 100,
+            "height": 100,
+            "rotation": 0
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          }
+        },
+        {
+          "type": "server",
+          "name": "Database",
+          "config": {
+            "type": "database",
+            "bulbColor": {
+              "fixed": "red"
+            },
+            "blinkRate": {
+              "fixed": 2,
+              "min": 0,
+              "max": 100
+            }
+          },
+          "background": {
+            "color": {
+              "fixed": "transparent"
+            }
+          },
+          "border": {
+            "color": {
+              "fixed": "dark-green"
+            }
+          },
+          "placement": {
+            "top": 0,
+            "left": 0,
+            "width": 100,
+            "height": 100,
+            "rotation": 0
+          },
+          "constraint": {
+            "horizontal": "left",
+            "vertical": "top"
+          }
+        }
+      ],
+      "background": {
+        "color": {
+          "fixed": "transparent"
+        }
+      },
+      "border": {
+        "color": {
+          "fixed": "dark-green"
+        }
+      },
+      "constraint": {
+        "horizontal": "left",
+        "vertical": "top"
+      }
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "reqps",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccCanvasDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    canvas {
+      field {
+        unit = "percent"
+      }
+
+      graph {
+        inline_editing = false
+        pan_zoom       = true
+      }
+    }
+  }
+}
+
+data "gdashboard_canvas" "test" {
+  title = "Test"
+}
+`
+
+const testAccCanvasDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "canvas",
+  "options": {
+    "inlineEditing": false,
+    "showAdvancedTypes": true,
+    "panZoom": true,
+    "root": {
+      "type": "frame",
+      "name": "Element 0",
+      "elements": [],
+      "background": {
+        "color": {
+          "fixed": "transparent"
+        }
+      },
+      "border": {
+        "color": {
+          "fixed": "dark-green"
+        }
+      },
+      "constraint": {
+        "horizontal": "left",
+        "vertical": "top"
+      }
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccCanvasDataSourceProviderDefaultsConfig = `
+data "gdashboard_canvas" "test" {
+  title = "Test"
+}
+`
+
+const testAccCanvasDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "canvas",
+  "options": {
+    "inlineEditing": true,
+    "showAdvancedTypes": true,
+    "panZoom": false,
+    "root": {
+      "type": "frame",
+      "name": "Element 0",
+      "elements": [],
+      "background": {
+        "color": {
+          "fixed": "transparent"
+        }
+      },
+      "border": {
+        "color": {
+          "fixed": "dark-green"
+        }
+      },
+      "constraint": {
+        "horizontal": "left",
+        "vertical": "top"
+      }
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
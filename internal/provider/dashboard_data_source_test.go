@@ -151,6 +151,14 @@ func TestAccDashboardDataSource(t *testing.T) {
 				Config: testAccDashboardDataSourceProvider_Layout_Multilevel,
 				Check:  resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceProvider_Layout_Multilevel_ExpectedJson),
 			},
+			{
+				Config: testAccDashboardDataSourceProvider_Layout_Panel_Source,
+				Check:  resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceProvider_Layout_Panel_Source_ExpectedJson),
+			},
+			{
+				Config: testAccDashboardDataSourceProvider_Layout_Panel_Source_Dashlist,
+				Check:  resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceProvider_Layout_Panel_Source_Dashlist_ExpectedJson),
+			},
 			{
 				Config:      testAccDashboardDataSourceProvider_Annotations_Grafana_Clashing_Fields,
 				ExpectError: regexp.MustCompile("Attribute \"annotations\\[0]\\.grafana\\[0]\\.by_tags\" cannot be specified when"),
@@ -1832,6 +1840,498 @@ const testAccDashboardDataSourceProvider_Layout_Multilevel_ExpectedJson = `{
 
 //// Annotations start
 
+const testAccDashboardDataSourceProvider_Layout_Panel_Source = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  layout {
+    section {
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title           = "Legacy heatmap"
+          type            = "heatmap"
+          dataFormat      = "tsbuckets"
+          cards           = { cardPadding = 1, cardRound = null }
+          color           = { mode = "spectrum", cardColor = "#b4ff00", colorScale = "sqrt", colorScheme = "interpolateOranges", exponent = 0.5 }
+          yAxis           = { show = true, format = "s", decimals = 1, logBase = 1, splitFactor = null, min = null, max = null }
+          hideZeroBuckets = true
+          yBucketBound    = "auto"
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "Heatmap"
+          type  = "heatmap"
+          options = {
+            calculate = false
+            cellGap   = 2
+            cellValues = { unit = "short", decimals = 0 }
+            filterValues = { le = 1e-9 }
+            yAxis = { axisPlacement = "left", unit = "s", decimals = 2 }
+          }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title         = "Pie chart"
+          type          = "piechart"
+          links         = [{ title = "Runbook", url = "https://example.com/runbook" }]
+          maxDataPoints = 100
+          timeFrom      = "1h"
+          options = {
+            pieType = "donut"
+            legend  = { displayMode = "list", placement = "right", showLegend = true }
+          }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "State timeline"
+          type  = "state-timeline"
+          options = {
+            mergeValues = true
+            perPage     = 20
+          }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "XY chart"
+          type  = "xychart"
+          options = {
+            seriesMapping = "manual"
+            series        = [{ x = "cpu", y = "throughput", pointColor = { fixed = "red" } }]
+            mapping       = "manual"
+          }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "Node graph"
+          type  = "nodeGraph"
+          options = {
+            nodes = { mainStatUnit = "ms", layoutAlgorithm = "force" }
+          }
+          fieldConfig = { defaults = {}, overrides = [] }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "Traces"
+          type  = "traces"
+          options = {
+            spanFilters = { serviceName = "api" }
+          }
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "Alert list"
+          type  = "alertlist"
+          options = {
+            viewMode    = "list"
+            stateFilter = { firing = true, pending = true }
+          }
+        })
+      }
+    }
+  }
+}`
+
+const testAccDashboardDataSourceProvider_Layout_Panel_Source_ExpectedJson = `{
+  "title": "Test",
+  "style": "dark",
+  "timezone": "",
+  "liveNow": false,
+  "editable": true,
+  "panels": [
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 0,
+        "y": 0
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Legacy heatmap",
+      "transparent": false,
+      "type": "heatmap",
+      "cards": {
+        "cardPadding": 1,
+        "cardRound": null
+      },
+      "color": {
+        "cardColor": "#b4ff00",
+        "colorScale": "sqrt",
+        "colorScheme": "interpolateOranges",
+        "exponent": 0.5,
+        "mode": "spectrum"
+      },
+      "dataFormat": "tsbuckets",
+      "hideZeroBuckets": true,
+      "yAxis": {
+        "decimals": 1,
+        "format": "s",
+        "logBase": 1,
+        "max": null,
+        "min": null,
+        "show": true,
+        "splitFactor": null
+      },
+      "yBucketBound": "auto"
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 12,
+        "y": 0
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Heatmap",
+      "transparent": false,
+      "type": "heatmap",
+      "options": {
+        "calculate": false,
+        "cellGap": 2,
+        "cellValues": {
+          "decimals": 0,
+          "unit": "short"
+        },
+        "filterValues": {
+          "le": 0.000000001
+        },
+        "yAxis": {
+          "axisPlacement": "left",
+          "decimals": 2,
+          "unit": "s"
+        }
+      }
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 0,
+        "y": 8
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Pie chart",
+      "transparent": false,
+      "type": "piechart",
+      "links": [
+        {
+          "title": "Runbook",
+          "url": "https://example.com/runbook"
+        }
+      ],
+      "maxDataPoints": 100,
+      "options": {
+        "legend": {
+          "displayMode": "list",
+          "placement": "right",
+          "showLegend": true
+        },
+        "pieType": "donut"
+      },
+      "timeFrom": "1h",
+      "title": "Pie chart",
+      "type": "piechart"
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 12,
+        "y": 8
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "State timeline",
+      "transparent": false,
+      "type": "state-timeline",
+      "options": {
+        "mergeValues": true,
+        "perPage": 20
+      },
+      "title": "State timeline",
+      "type": "state-timeline"
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 0,
+        "y": 16
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "XY chart",
+      "transparent": false,
+      "type": "xychart",
+      "options": {
+        "mapping": "manual",
+        "series": [
+          {
+            "pointColor": {
+              "fixed": "red"
+            },
+            "x": "cpu",
+            "y": "throughput"
+          }
+        ],
+        "seriesMapping": "manual"
+      }
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 12,
+        "y": 16
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Node graph",
+      "transparent": false,
+      "type": "nodeGraph",
+      "fieldConfig": {
+        "defaults": {},
+        "overrides": []
+      },
+      "options": {
+        "nodes": {
+          "layoutAlgorithm": "force",
+          "mainStatUnit": "ms"
+        }
+      }
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 0,
+        "y": 24
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Traces",
+      "transparent": false,
+      "type": "traces",
+      "options": {
+        "spanFilters": {
+          "serviceName": "api"
+        }
+      }
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 12,
+        "y": 24
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Alert list",
+      "transparent": false,
+      "type": "alertlist",
+      "options": {
+        "stateFilter": {
+          "firing": true,
+          "pending": true
+        },
+        "viewMode": "list"
+      }
+    }
+  ],
+  "templating": {
+    "list": []
+  },
+  "annotations": {
+    "list": null
+  },
+  "schemaVersion": 0,
+  "version": 1,
+  "links": null,
+  "time": {
+    "from": "now-6h",
+    "to": "now"
+  },
+  "timepicker": {
+    "refresh_intervals": null,
+    "time_options": null
+  }
+}`
+
+const testAccDashboardDataSourceProvider_Layout_Panel_Source_Dashlist = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  layout {
+    section {
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title    = "Legacy dashboard list"
+          type     = "dashlist"
+          headings = false
+          query    = ""
+          tags     = []
+          starred  = true
+          limit    = 10
+        })
+      }
+      panel {
+        size = {
+          height = 8
+          width  = 12
+        }
+        source = jsonencode({
+          title = "Dashboard list"
+          type  = "dashlist"
+          options = {
+            showStarred = true
+            folderId    = 3
+            maxItems    = 5
+          }
+        })
+      }
+    }
+  }
+}`
+
+const testAccDashboardDataSourceProvider_Layout_Panel_Source_Dashlist_ExpectedJson = `{
+  "title": "Test",
+  "style": "dark",
+  "timezone": "",
+  "liveNow": false,
+  "editable": true,
+  "panels": [
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 0,
+        "y": 0
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Legacy dashboard list",
+      "transparent": false,
+      "type": "dashlist",
+      "headings": false,
+      "limit": 10,
+      "query": "",
+      "starred": true,
+      "tags": []
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 8,
+        "w": 12,
+        "x": 12,
+        "y": 0
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Dashboard list",
+      "transparent": false,
+      "type": "dashlist",
+      "options": {
+        "folderId": 3,
+        "maxItems": 5,
+        "showStarred": true
+      }
+    }
+  ],
+  "templating": {
+    "list": []
+  },
+  "annotations": {
+    "list": null
+  },
+  "schemaVersion": 0,
+  "version": 1,
+  "links": null,
+  "time": {
+    "from": "now-6h",
+    "to": "now"
+  },
+  "timepicker": {
+    "refresh_intervals": null,
+    "time_options": null
+  }
+}`
+
 const testAccDashboardDataSourceProvider_Annotations_Datasource_Valid = `
 data "gdashboard_dashboard" "test" {
   title = "Test"
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DashboardListDataSource{}
+
+func NewDashboardListDataSource() datasource.DataSource {
+	return &DashboardListDataSource{}
+}
+
+// DashboardListDataSource defines the data source implementation.
+type DashboardListDataSource struct {
+	CompactJson bool
+}
+
+// DashboardListDataSourceModel describes the data source data model.
+type DashboardListDataSourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Json        types.String           `tfsdk:"json"`
+	CompactJson types.Bool             `tfsdk:"compact_json"`
+	Title       types.String           `tfsdk:"title"`
+	Description types.String           `tfsdk:"description"`
+	Graph       []DashboardListOptions `tfsdk:"graph"`
+}
+
+type DashboardListOptions struct {
+	Starred          types.Bool     `tfsdk:"starred"`
+	RecentlyViewed   types.Bool     `tfsdk:"recently_viewed"`
+	Search           types.Bool     `tfsdk:"search"`
+	Headings         types.Bool     `tfsdk:"headings"`
+	Limit            types.Int64    `tfsdk:"limit"`
+	Query            types.String   `tfsdk:"query"`
+	Tags             []types.String `tfsdk:"tags"`
+	FolderUID        types.String   `tfsdk:"folder_uid"`
+	IncludeVariables types.Bool     `tfsdk:"include_variables"`
+	IncludeTimeRange types.Bool     `tfsdk:"include_time_range"`
+}
+
+func (d *DashboardListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_list"
+}
+
+func dashboardListGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"starred": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the starred dashboards or not. Defaults to true.",
+				},
+				"recently_viewed": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the recently viewed dashboards or not. Defaults to false.",
+				},
+				"search": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the dashboards matching the search options (query, tags, folder) or not. Defaults to false.",
+				},
+				"headings": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the headings of the sections (starred, recently viewed, search) or not. Defaults to true.",
+				},
+				"limit": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The maximum number of dashboards to show in each section. Defaults to 10.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"query": schema.StringAttribute{
+					Optional:    true,
+					Description: "The search query to filter the dashboards by title.",
+				},
+				"tags": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "The tags to filter the dashboards by.",
+				},
+				"folder_uid": schema.StringAttribute{
+					Optional:    true,
+					Description: "The UID of the folder to search the dashboards in.",
+				},
+				"include_variables": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to include the current template variables in the dashboard links or not.",
+				},
+				"include_time_range": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to include the current time range in the dashboard links or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *DashboardListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Dashboard list panel data source.",
+		MarkdownDescription: "Dashboard list panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/dashboard-list/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"graph": dashboardListGraphBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *DashboardListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *DashboardListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DashboardListDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := grafana.DashlistOptions{
+		ShowStarred:        true,
+		ShowRecentlyViewed: false,
+		ShowSearch:         false,
+		ShowHeadings:       true,
+		MaxItems:           10,
+		Query:              "",
+		Tags:               []string{},
+		IncludeVars:        false,
+		KeepTime:           false,
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.Starred.IsNull() {
+			options.ShowStarred = graph.Starred.ValueBool()
+		}
+
+		if !graph.RecentlyViewed.IsNull() {
+			options.ShowRecentlyViewed = graph.RecentlyViewed.ValueBool()
+		}
+
+		if !graph.Search.IsNull() {
+			options.ShowSearch = graph.Search.ValueBool()
+		}
+
+		if !graph.Headings.IsNull() {
+			options.ShowHeadings = graph.Headings.ValueBool()
+		}
+
+		if !graph.Limit.IsNull() {
+			options.MaxItems = graph.Limit.ValueInt64()
+		}
+
+		if !graph.Query.IsNull() {
+			options.Query = graph.Query.ValueString()
+		}
+
+		if len(graph.Tags) > 0 {
+			tags := make([]string, len(graph.Tags))
+			for i, tag := range graph.Tags {
+				tags[i] = tag.ValueString()
+			}
+
+			options.Tags = tags
+		}
+
+		if !graph.FolderUID.IsNull() {
+			options.FolderUID = graph.FolderUID.ValueString()
+		}
+
+		if !graph.IncludeVariables.IsNull() {
+			options.IncludeVars = graph.IncludeVariables.ValueBool()
+		}
+
+		if !graph.IncludeTimeRange.IsNull() {
+			options.KeepTime = graph.IncludeTimeRange.ValueBool()
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType: grafana.DashlistType,
+			Title:  data.Title.ValueString(),
+			Type:   "dashlist",
+			Span:   12,
+			IsNew:  true,
+		},
+		DashlistPanel: &grafana.DashlistPanel{
+			Options: &options,
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDashboardListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDashboardListDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard_list.test", "json", testAccDashboardListDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccDashboardListDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard_list.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard_list.test", "json", testAccDashboardListDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccDashboardListDataSourceConfig = `
+data "gdashboard_dashboard_list" "test" {
+  title       = "Test"
+  description = "Dashboard list description"
+
+  graph {
+    starred            = false
+    recently_viewed    = true
+    search             = true
+    headings           = false
+    limit              = 5
+    query              = "Service"
+    tags               = ["production", "backend"]
+    folder_uid         = "services"
+    include_variables  = true
+    include_time_range = true
+  }
+}
+`
+
+const testAccDashboardListDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Dashboard list description",
+  "transparent": false,
+  "type": "dashlist",
+  "mode": "",
+  "query": "",
+  "tags": null,
+  "folderId": 0,
+  "limit": 0,
+  "headings": false,
+  "recent": false,
+  "search": false,
+  "starred": false,
+  "options": {
+    "showStarred": false,
+    "showRecentlyViewed": true,
+    "showSearch": true,
+    "showHeadings": false,
+    "maxItems": 5,
+    "query": "Service",
+    "tags": [
+      "production",
+      "backend"
+    ],
+    "folderUID": "services",
+    "includeVars": true,
+    "keepTime": true
+  }
+}`
+
+const testAccDashboardListDataSourceProviderDefaultsConfig = `
+data "gdashboard_dashboard_list" "test" {
+  title = "Test"
+}
+`
+
+const testAccDashboardListDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "dashlist",
+  "mode": "",
+  "query": "",
+  "tags": null,
+  "folderId": 0,
+  "limit": 0,
+  "headings": false,
+  "recent": false,
+  "search": false,
+  "starred": false,
+  "options": {
+    "showStarred": true,
+    "showRecentlyViewed": false,
+    "showSearch": false,
+    "showHeadings": true,
+    "maxItems": 10,
+    "query": "",
+    "tags": [],
+    "includeVars": false,
+    "keepTime": false
+  }
+}`
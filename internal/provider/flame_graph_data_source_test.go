@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFlameGraphDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFlameGraphDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_flame_graph.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_flame_graph.test", "json", testAccFlameGraphDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccFlameGraphDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_flame_graph.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_flame_graph.test", "json", testAccFlameGraphDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccFlameGraphDataSourceConfig = `
+data "gdashboard_flame_graph" "test" {
+  title       = "Test"
+  description = "Flame graph description"
+
+  queries {
+    min_interval = "1m"
+
+    pyroscope {
+      uid             = "pyroscope"
+      profile_type_id = "process_cpu:cpu:nanoseconds:cpu:nanoseconds"
+      label_selector  = "{service_name=\"backend\"}"
+      group_by        = ["pod"]
+      max_nodes       = 1024
+      query_type      = "both"
+      ref_id          = "A"
+    }
+
+    pyroscope {
+      uid             = "pyroscope"
+      hide            = true
+      profile_type_id = "memory:alloc_space:bytes:space:bytes"
+    }
+  }
+}
+`
+
+const testAccFlameGraphDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Flame graph description",
+  "transparent": false,
+  "type": "flamegraph",
+  "interval": "1m",
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "pyroscope",
+        "name": "",
+        "type": "grafana-pyroscope-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "both",
+      "profileTypeId": "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+      "labelSelector": "{service_name=\"backend\"}",
+      "groupBy": [
+        "pod"
+      ],
+      "maxNodes": 1024
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "pyroscope",
+        "name": "",
+        "type": "grafana-pyroscope-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "queryType": "profile",
+      "profileTypeId": "memory:alloc_space:bytes:space:bytes",
+      "labelSelector": "{}"
+    }
+  ]
+}`
+
+const testAccFlameGraphDataSourceProviderDefaultsConfig = `
+data "gdashboard_flame_graph" "test" {
+  title = "Test"
+}
+`
+
+const testAccFlameGraphDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "flamegraph"
+}`
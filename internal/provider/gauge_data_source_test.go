@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -19,6 +20,20 @@ func TestAccGaugeDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "json", testAccGaugeDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccGaugeDataSourceAzureMonitorConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "json", testAccGaugeDataSourceAzureMonitorConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccGaugeDataSourceCloudMonitoringConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "json", testAccGaugeDataSourceCloudMonitoringConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccGaugeDataSourceProviderCustomDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -33,10 +48,42 @@ func TestAccGaugeDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_gauge.test", "json", testAccGaugeDataSourceProviderDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config:      testAccGaugeDataSourceAzureMonitorMissingQueryConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+			{
+				Config:      testAccGaugeDataSourceCloudMonitoringMissingQueryConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
 		},
 	})
 }
 
+const testAccGaugeDataSourceAzureMonitorMissingQueryConfig = `
+data "gdashboard_gauge" "test" {
+  title = "Test"
+
+  queries {
+    azure_monitor {
+      uid = "azure"
+    }
+  }
+}
+`
+
+const testAccGaugeDataSourceCloudMonitoringMissingQueryConfig = `
+data "gdashboard_gauge" "test" {
+  title = "Test"
+
+  queries {
+    google_cloud_monitoring {
+      uid = "stackdriver"
+    }
+  }
+}
+`
+
 const testAccGaugeDataSourceConfig = `
 data "gdashboard_gauge" "test" {
   title       = "Test"
@@ -214,6 +261,258 @@ const testAccGaugeDataSourceConfigExpectedJson = `{
   }
 }`
 
+const testAccGaugeDataSourceAzureMonitorConfig = `
+data "gdashboard_gauge" "test" {
+  title = "Test"
+
+  queries {
+    azure_monitor {
+      uid  = "azure"
+      hide = true
+
+      logs {
+        query              = "Perf | where $__timeFilter(TimeGenerated) | summarize avg(CounterValue) by bin(TimeGenerated, 5m)"
+        resources          = ["/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/production/providers/Microsoft.OperationalInsights/workspaces/logs"]
+        result_format      = "time_series"
+        use_dashboard_time = true
+        time_column        = "TimeGenerated"
+      }
+    }
+  }
+}
+`
+
+const testAccGaugeDataSourceAzureMonitorConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "gauge",
+  "options": {
+    "orientation": "auto",
+    "textMode": "",
+    "colorMode": "",
+    "graphMode": "",
+    "justifyMode": "",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showThresholdLabels": false,
+    "showThresholdMarkers": true,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "azure",
+        "name": "",
+        "type": "grafana-azure-monitor-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "queryType": "Azure Log Analytics",
+      "azureLogAnalytics": {
+        "query": "Perf | where $__timeFilter(TimeGenerated) | summarize avg(CounterValue) by bin(TimeGenerated, 5m)",
+        "resources": [
+          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/production/providers/Microsoft.OperationalInsights/workspaces/logs"
+        ],
+        "resultFormat": "time_series",
+        "dashboardTime": true,
+        "timeColumn": "TimeGenerated"
+      }
+    }
+  ],
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccGaugeDataSourceCloudMonitoringConfig = `
+data "gdashboard_gauge" "test" {
+  title = "Test"
+
+  queries {
+    google_cloud_monitoring {
+      uid = "stackdriver"
+
+      mql {
+        project = "my-project"
+        query   = "fetch gce_instance | metric 'compute.googleapis.com/instance/cpu/utilization' | every 1m"
+      }
+    }
+  }
+}
+`
+
+const testAccGaugeDataSourceCloudMonitoringConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "gauge",
+  "options": {
+    "orientation": "auto",
+    "textMode": "",
+    "colorMode": "",
+    "graphMode": "",
+    "justifyMode": "",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showThresholdLabels": false,
+    "showThresholdMarkers": true,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "stackdriver",
+        "name": "",
+        "type": "stackdriver",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "timeSeriesQuery",
+      "timeSeriesQuery": {
+        "projectName": "my-project",
+        "query": "fetch gce_instance | metric 'compute.googleapis.com/instance/cpu/utilization' | every 1m"
+      }
+    }
+  ],
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
 const testAccGaugeDataSourceProviderCustomDefaultsConfig = `
 provider "gdashboard" {
   defaults {
@@ -0,0 +1,937 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GeomapDataSource{}
+
+func NewGeomapDataSource() datasource.DataSource {
+	return &GeomapDataSource{}
+}
+
+// GeomapDataSource defines the data source implementation.
+type GeomapDataSource struct {
+	CompactJson bool
+	Defaults    GeomapDefaults
+}
+
+type GeomapDefaults struct {
+	Field     FieldDefaults
+	View      GeomapViewDefaults
+	Controls  GeomapControlsDefaults
+	BaseLayer GeomapBaseLayerDefaults
+	Tooltip   GeomapTooltipDefaults
+}
+
+type GeomapViewDefaults struct {
+	FitToData bool
+	Latitude  float64
+	Longitude float64
+	Zoom      float64
+}
+
+type GeomapControlsDefaults struct {
+	ShowZoom        bool
+	MouseWheelZoom  bool
+	ShowAttribution bool
+	ShowScale       bool
+	ShowMeasure     bool
+	ShowDebug       bool
+}
+
+type GeomapBaseLayerDefaults struct {
+	Type        string
+	Theme       string
+	ShowLabels  *bool
+	URL         string
+	Attribution string
+}
+
+type GeomapTooltipDefaults struct {
+	Mode string
+}
+
+// GeomapDataSourceModel describes the data source data model.
+type GeomapDataSourceModel struct {
+	Id              types.String             `tfsdk:"id"`
+	Json            types.String             `tfsdk:"json"`
+	CompactJson     types.Bool               `tfsdk:"compact_json"`
+	Title           types.String             `tfsdk:"title"`
+	Description     types.String             `tfsdk:"description"`
+	Queries         []Query                  `tfsdk:"queries"`
+	Field           []FieldOptions           `tfsdk:"field"`
+	View            []GeomapViewOptions      `tfsdk:"view"`
+	Controls        []GeomapControlsOptions  `tfsdk:"controls"`
+	BaseLayer       []GeomapBaseLayerOptions `tfsdk:"base_layer"`
+	Layers          []GeomapLayerOptions     `tfsdk:"layer"`
+	Tooltip         []GeomapTooltipOptions   `tfsdk:"tooltip"`
+	Overrides       []FieldOverrideOptions   `tfsdk:"overrides"`
+	Transformations []Transformations        `tfsdk:"transform"`
+}
+
+type GeomapViewOptions struct {
+	FitToData types.Bool    `tfsdk:"fit_to_data"`
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+	Zoom      types.Float64 `tfsdk:"zoom"`
+}
+
+type GeomapControlsOptions struct {
+	ShowZoom        types.Bool `tfsdk:"show_zoom"`
+	MouseWheelZoom  types.Bool `tfsdk:"mouse_wheel_zoom"`
+	ShowAttribution types.Bool `tfsdk:"show_attribution"`
+	ShowScale       types.Bool `tfsdk:"show_scale"`
+	ShowMeasure     types.Bool `tfsdk:"show_measure"`
+	ShowDebug       types.Bool `tfsdk:"show_debug"`
+}
+
+type GeomapBaseLayerOptions struct {
+	Type        types.String `tfsdk:"type"`
+	Theme       types.String `tfsdk:"theme"`
+	ShowLabels  types.Bool   `tfsdk:"show_labels"`
+	URL         types.String `tfsdk:"url"`
+	Attribution types.String `tfsdk:"attribution"`
+}
+
+type GeomapLayerOptions struct {
+	Type       types.String            `tfsdk:"type"`
+	Name       types.String            `tfsdk:"name"`
+	Tooltip    types.Bool              `tfsdk:"tooltip"`
+	ShowLegend types.Bool              `tfsdk:"show_legend"`
+	Location   []GeomapLocationOptions `tfsdk:"location"`
+	Style      []GeomapStyleOptions    `tfsdk:"style"`
+	Heatmap    []GeomapHeatmapOptions  `tfsdk:"heatmap"`
+	GeoJsonURL types.String            `tfsdk:"geojson_url"`
+	Arrow      types.String            `tfsdk:"arrow"`
+}
+
+type GeomapLocationOptions struct {
+	Mode           types.String `tfsdk:"mode"`
+	LatitudeField  types.String `tfsdk:"latitude_field"`
+	LongitudeField types.String `tfsdk:"longitude_field"`
+	GeohashField   types.String `tfsdk:"geohash_field"`
+	LookupField    types.String `tfsdk:"lookup_field"`
+	Gazetteer      types.String `tfsdk:"gazetteer"`
+}
+
+type GeomapStyleOptions struct {
+	Size       types.Float64 `tfsdk:"size"`
+	SizeField  types.String  `tfsdk:"size_field"`
+	SizeMin    types.Float64 `tfsdk:"size_min"`
+	SizeMax    types.Float64 `tfsdk:"size_max"`
+	Color      types.String  `tfsdk:"color"`
+	ColorField types.String  `tfsdk:"color_field"`
+	Opacity    types.Float64 `tfsdk:"opacity"`
+	Symbol     types.String  `tfsdk:"symbol"`
+}
+
+type GeomapHeatmapOptions struct {
+	Blur        types.Int64  `tfsdk:"blur"`
+	Radius      types.Int64  `tfsdk:"radius"`
+	WeightField types.String `tfsdk:"weight_field"`
+}
+
+type GeomapTooltipOptions struct {
+	Mode types.String `tfsdk:"mode"`
+}
+
+func (d *GeomapDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geomap"
+}
+
+func geomapViewBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The initial view of the map.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"fit_to_data": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to fit the initial view to the data of all layers or not.",
+					Validators: []validator.Bool{
+						boolvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName("latitude"),
+							path.MatchRelative().AtParent().AtName("longitude"),
+						),
+					},
+				},
+				"latitude": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The latitude of the center of the map. Must be between -90 and 90 (inclusive).",
+					MarkdownDescription: "The latitude of the center of the map. Must be between `-90` and `90` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(-90, 90),
+						float64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("longitude")),
+					},
+				},
+				"longitude": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The longitude of the center of the map. Must be between -180 and 180 (inclusive).",
+					MarkdownDescription: "The longitude of the center of the map. Must be between `-180` and `180` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(-180, 180),
+						float64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("latitude")),
+					},
+				},
+				"zoom": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The initial zoom level. Must be between 1 and 18 (inclusive).",
+					MarkdownDescription: "The initial zoom level. Must be between `1` and `18` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(1, 18),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func geomapControlsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The map controls.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"show_zoom": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the zoom control or not.",
+				},
+				"mouse_wheel_zoom": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to allow zooming with the mouse wheel or not.",
+				},
+				"show_attribution": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the attribution of the base layer or not.",
+				},
+				"show_scale": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the scale of the map or not.",
+				},
+				"show_measure": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the measure tool or not.",
+				},
+				"show_debug": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the zoom level and the coordinates of the center or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func geomapBaseLayerBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The base layer of the map.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The type of the base layer. The choices are: default, osm-standard, carto, esri-xyz, xyz.",
+					MarkdownDescription: "The type of the base layer. The choices are: `default`, `osm-standard`, `carto`, `esri-xyz`, `xyz`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("default", "osm-standard", "carto", "esri-xyz", "xyz"),
+					},
+				},
+				"theme": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The theme of the carto base layer. The choices are: auto, light, dark.",
+					MarkdownDescription: "The theme of the `carto` base layer. The choices are: `auto`, `light`, `dark`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "light", "dark"),
+					},
+				},
+				"show_labels": schema.BoolAttribute{
+					Optional:            true,
+					Description:         "Whether to show the labels of the carto base layer or not.",
+					MarkdownDescription: "Whether to show the labels of the `carto` base layer or not.",
+				},
+				"url": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The URL template of the xyz tile server. For example: https://tile.openstreetmap.org/{z}/{x}/{y}.png.",
+					MarkdownDescription: "The URL template of the `xyz` tile server. For example: `https://tile.openstreetmap.org/{z}/{x}/{y}.png`.",
+				},
+				"attribution": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The attribution of the xyz tile server.",
+					MarkdownDescription: "The attribution of the `xyz` tile server.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func geomapLayerBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The data layers of the map. The layers are rendered in the order of definition.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"location": schema.ListNestedBlock{
+					Description: "Defines how to find the location of the data points.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Required:            true,
+								Description:         "The location mode. The choices are: auto, coords, geohash, lookup.",
+								MarkdownDescription: "The location mode. The choices are: `auto`, `coords`, `geohash`, `lookup`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("auto", "coords", "geohash", "lookup"),
+								},
+							},
+							"latitude_field": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The name of the field with the latitude. Required with the coords mode.",
+								MarkdownDescription: "The name of the field with the latitude. Required with the `coords` mode.",
+							},
+							"longitude_field": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The name of the field with the longitude. Required with the coords mode.",
+								MarkdownDescription: "The name of the field with the longitude. Required with the `coords` mode.",
+							},
+							"geohash_field": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The name of the field with the geohash. Required with the geohash mode.",
+								MarkdownDescription: "The name of the field with the geohash. Required with the `geohash` mode.",
+							},
+							"lookup_field": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The name of the field with the lookup key. Required with the lookup mode.",
+								MarkdownDescription: "The name of the field with the lookup key. Required with the `lookup` mode.",
+							},
+							"gazetteer": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The path of the gazetteer to resolve the lookup key. For example: public/gazetteer/countries.json.",
+								MarkdownDescription: "The path of the gazetteer to resolve the lookup key. For example: `public/gazetteer/countries.json`.",
+							},
+						},
+						Validators: []validator.Object{
+							geomapLocationValidator{},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"style": schema.ListNestedBlock{
+					Description:         "The style of the data points. Used with the markers, geojson and route layers.",
+					MarkdownDescription: "The style of the data points. Used with the `markers`, `geojson` and `route` layers.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"size": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The fixed size of the markers.",
+							},
+							"size_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to scale the size of the markers by.",
+							},
+							"size_min": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The minimum size of the markers when the size is bound to a field.",
+							},
+							"size_max": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The maximum size of the markers when the size is bound to a field.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The fixed color of the markers.",
+							},
+							"color_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to color the markers by.",
+							},
+							"opacity": schema.Float64Attribute{
+								Optional:            true,
+								Description:         "The fill opacity of the markers. Must be between 0 and 1 (inclusive).",
+								MarkdownDescription: "The fill opacity of the markers. Must be between `0` and `1` (inclusive).",
+								Validators: []validator.Float64{
+									float64validator.Between(0, 1),
+								},
+							},
+							"symbol": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The symbol of the markers. The choices are: circle, square, triangle, star, cross, x.",
+								MarkdownDescription: "The symbol of the markers. The choices are: `circle`, `square`, `triangle`, `star`, `cross`, `x`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("circle", "square", "triangle", "star", "cross", "x"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"heatmap": schema.ListNestedBlock{
+					Description:         "The heatmap options. Used with the heatmap layer.",
+					MarkdownDescription: "The heatmap options. Used with the `heatmap` layer.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"blur": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "The blur size in pixels. Must be between 1 and 100 (inclusive).",
+								MarkdownDescription: "The blur size in pixels. Must be between `1` and `100` (inclusive).",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 100),
+								},
+							},
+							"radius": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "The radius size in pixels. Must be between 1 and 50 (inclusive).",
+								MarkdownDescription: "The radius size in pixels. Must be between `1` and `50` (inclusive).",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 50),
+								},
+							},
+							"weight_field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to scale the weight of the data points by.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					Description:         "The type of the layer. The choices are: markers, heatmap, geojson, route.",
+					MarkdownDescription: "The type of the layer. The choices are: `markers`, `heatmap`, `geojson`, `route`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("markers", "heatmap", "geojson", "route"),
+					},
+				},
+				"name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the layer. By default, the name is generated from the position of the layer.",
+				},
+				"tooltip": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the tooltip for the layer or not. Defaults to true.",
+				},
+				"show_legend": schema.BoolAttribute{
+					Optional:            true,
+					Description:         "Whether to show the legend of the layer or not. Used with the markers layer. Defaults to true.",
+					MarkdownDescription: "Whether to show the legend of the layer or not. Used with the `markers` layer. Defaults to `true`.",
+				},
+				"geojson_url": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The URL of the GeoJSON file. Used with the geojson layer. Defaults to public/maps/countries.geojson.",
+					MarkdownDescription: "The URL of the GeoJSON file. Used with the `geojson` layer. Defaults to `public/maps/countries.geojson`.",
+				},
+				"arrow": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The direction of the arrows. Used with the route layer. The choices are: none, forward, reverse.",
+					MarkdownDescription: "The direction of the arrows. Used with the `route` layer. The choices are: `none`, `forward`, `reverse`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "forward", "reverse"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func geomapTooltipBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The tooltip visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Required:            true,
+					Description:         "Choose the how to display the tooltip. The choices are: details, none.",
+					MarkdownDescription: "Choose the how to display the tooltip. The choices are: `details`, `none`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("details", "none"),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *GeomapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Geomap panel data source.",
+		MarkdownDescription: "Geomap panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/geomap/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":    queryBlock(),
+			"field":      fieldBlock(false),
+			"view":       geomapViewBlock(),
+			"controls":   geomapControlsBlock(),
+			"base_layer": geomapBaseLayerBlock(),
+			"layer":      geomapLayerBlock(),
+			"tooltip":    geomapTooltipBlock(),
+			"overrides":  fieldOverrideBlock(false),
+			"transform":  transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *GeomapDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.Geomap
+}
+
+func (d *GeomapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GeomapDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+	transformations := createTransformations(data.Transformations)
+
+	view := d.Defaults.View
+	controls := d.Defaults.Controls
+	baseLayer := d.Defaults.BaseLayer
+	tooltip := d.Defaults.Tooltip
+
+	for _, v := range data.View {
+		updateGeomapViewDefaults(&view, v)
+	}
+
+	for _, c := range data.Controls {
+		updateGeomapControlsDefaults(&controls, c)
+	}
+
+	for _, b := range data.BaseLayer {
+		updateGeomapBaseLayerDefaults(&baseLayer, b)
+	}
+
+	for _, t := range data.Tooltip {
+		tooltip.Mode = t.Mode.ValueString()
+	}
+
+	options := grafana.GeomapOptions{
+		View: createGeomapView(view),
+		Controls: grafana.GeomapControls{
+			ShowZoom:        controls.ShowZoom,
+			MouseWheelZoom:  controls.MouseWheelZoom,
+			ShowAttribution: controls.ShowAttribution,
+			ShowScale:       controls.ShowScale,
+			ShowMeasure:     controls.ShowMeasure,
+			ShowDebug:       controls.ShowDebug,
+		},
+		Basemap: createGeomapBaseLayer(baseLayer),
+		Layers:  make([]grafana.GeomapDataLayer, len(data.Layers)),
+		Tooltip: grafana.GeomapTooltip{
+			Mode: tooltip.Mode,
+		},
+	}
+
+	for i, layer := range data.Layers {
+		options.Layers[i] = createGeomapDataLayer(layer, i+1)
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.GeomapType,
+			Title:           data.Title.ValueString(),
+			Type:            "geomap",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		GeomapPanel: &grafana.GeomapPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func updateGeomapViewDefaults(defaults *GeomapViewDefaults, opts GeomapViewOptions) {
+	if !opts.FitToData.IsNull() {
+		defaults.FitToData = opts.FitToData.ValueBool()
+
+		if defaults.FitToData {
+			defaults.Latitude = 0
+			defaults.Longitude = 0
+		}
+	}
+
+	if !opts.Latitude.IsNull() {
+		defaults.FitToData = false
+		defaults.Latitude = opts.Latitude.ValueFloat64()
+	}
+
+	if !opts.Longitude.IsNull() {
+		defaults.FitToData = false
+		defaults.Longitude = opts.Longitude.ValueFloat64()
+	}
+
+	if !opts.Zoom.IsNull() {
+		defaults.Zoom = opts.Zoom.ValueFloat64()
+	}
+}
+
+func updateGeomapControlsDefaults(defaults *GeomapControlsDefaults, opts GeomapControlsOptions) {
+	if !opts.ShowZoom.IsNull() {
+		defaults.ShowZoom = opts.ShowZoom.ValueBool()
+	}
+
+	if !opts.MouseWheelZoom.IsNull() {
+		defaults.MouseWheelZoom = opts.MouseWheelZoom.ValueBool()
+	}
+
+	if !opts.ShowAttribution.IsNull() {
+		defaults.ShowAttribution = opts.ShowAttribution.ValueBool()
+	}
+
+	if !opts.ShowScale.IsNull() {
+		defaults.ShowScale = opts.ShowScale.ValueBool()
+	}
+
+	if !opts.ShowMeasure.IsNull() {
+		defaults.ShowMeasure = opts.ShowMeasure.ValueBool()
+	}
+
+	if !opts.ShowDebug.IsNull() {
+		defaults.ShowDebug = opts.ShowDebug.ValueBool()
+	}
+}
+
+func updateGeomapBaseLayerDefaults(defaults *GeomapBaseLayerDefaults, opts GeomapBaseLayerOptions) {
+	if !opts.Type.IsNull() {
+		defaults.Type = opts.Type.ValueString()
+	}
+
+	if !opts.Theme.IsNull() {
+		defaults.Theme = opts.Theme.ValueString()
+	}
+
+	if !opts.ShowLabels.IsNull() {
+		defaults.ShowLabels = opts.ShowLabels.ValueBoolPointer()
+	}
+
+	if !opts.URL.IsNull() {
+		defaults.URL = opts.URL.ValueString()
+	}
+
+	if !opts.Attribution.IsNull() {
+		defaults.Attribution = opts.Attribution.ValueString()
+	}
+}
+
+func createGeomapView(view GeomapViewDefaults) grafana.GeomapView {
+	if view.FitToData {
+		return grafana.GeomapView{
+			ID:        "fit",
+			Zoom:      view.Zoom,
+			AllLayers: true,
+		}
+	}
+
+	if view.Latitude != 0 || view.Longitude != 0 {
+		return grafana.GeomapView{
+			ID:   "coords",
+			Lat:  view.Latitude,
+			Lon:  view.Longitude,
+			Zoom: view.Zoom,
+		}
+	}
+
+	return grafana.GeomapView{
+		ID:   "zero",
+		Zoom: view.Zoom,
+	}
+}
+
+func createGeomapBaseLayer(baseLayer GeomapBaseLayerDefaults) grafana.GeomapBaseLayer {
+	layer := grafana.GeomapBaseLayer{
+		Type: baseLayer.Type,
+		Name: "Layer 0",
+	}
+
+	switch baseLayer.Type {
+	case "carto":
+		layer.Config = &grafana.GeomapBaseLayerConfig{
+			Theme:      baseLayer.Theme,
+			ShowLabels: baseLayer.ShowLabels,
+		}
+	case "xyz":
+		layer.Config = &grafana.GeomapBaseLayerConfig{
+			URL:         baseLayer.URL,
+			Attribution: baseLayer.Attribution,
+		}
+	}
+
+	return layer
+}
+
+func createGeomapDataLayer(opts GeomapLayerOptions, index int) grafana.GeomapDataLayer {
+	layer := grafana.GeomapDataLayer{
+		Type: opts.Type.ValueString(),
+		Name: fmt.Sprintf("Layer %d", index),
+		Location: grafana.GeomapLocation{
+			Mode: "auto",
+		},
+		Tooltip: true,
+	}
+
+	if !opts.Name.IsNull() {
+		layer.Name = opts.Name.ValueString()
+	}
+
+	if !opts.Tooltip.IsNull() {
+		layer.Tooltip = opts.Tooltip.ValueBool()
+	}
+
+	for _, location := range opts.Location {
+		layer.Location = grafana.GeomapLocation{
+			Mode:      location.Mode.ValueString(),
+			Latitude:  location.LatitudeField.ValueString(),
+			Longitude: location.LongitudeField.ValueString(),
+			Geohash:   location.GeohashField.ValueString(),
+			Lookup:    location.LookupField.ValueString(),
+			Gazetteer: location.Gazetteer.ValueString(),
+		}
+	}
+
+	style := &grafana.GeomapStyle{
+		Size: grafana.ScaleDimension{
+			Fixed: 5,
+			Min:   2,
+			Max:   15,
+		},
+		Color: grafana.ColorDimension{
+			Fixed: "dark-green",
+		},
+		Opacity: 0.4,
+	}
+
+	for _, s := range opts.Style {
+		if !s.Size.IsNull() {
+			style.Size.Fixed = s.Size.ValueFloat64()
+		}
+
+		if !s.SizeField.IsNull() {
+			style.Size.Field = s.SizeField.ValueString()
+		}
+
+		if !s.SizeMin.IsNull() {
+			style.Size.Min = s.SizeMin.ValueFloat64()
+		}
+
+		if !s.SizeMax.IsNull() {
+			style.Size.Max = s.SizeMax.ValueFloat64()
+		}
+
+		if !s.Color.IsNull() {
+			style.Color.Fixed = s.Color.ValueString()
+		}
+
+		if !s.ColorField.IsNull() {
+			style.Color.Field = s.ColorField.ValueString()
+		}
+
+		if !s.Opacity.IsNull() {
+			style.Opacity = s.Opacity.ValueFloat64()
+		}
+
+		if !s.Symbol.IsNull() {
+			style.Symbol = &grafana.ResourceDimension{
+				Mode:  "fixed",
+				Fixed: fmt.Sprintf("img/icons/marker/%s.svg", s.Symbol.ValueString()),
+			}
+		}
+	}
+
+	switch layer.Type {
+	case "markers":
+		showLegend := true
+
+		if !opts.ShowLegend.IsNull() {
+			showLegend = opts.ShowLegend.ValueBool()
+		}
+
+		if style.Symbol == nil {
+			style.Symbol = &grafana.ResourceDimension{
+				Mode:  "fixed",
+				Fixed: "img/icons/marker/circle.svg",
+			}
+		}
+
+		layer.Config.ShowLegend = &showLegend
+		layer.Config.Style = style
+	case "heatmap":
+		blur := int64(15)
+		radius := int64(5)
+		weight := &grafana.ScaleDimension{
+			Fixed: 1,
+			Min:   0,
+			Max:   1,
+		}
+
+		for _, heatmap := range opts.Heatmap {
+			if !heatmap.Blur.IsNull() {
+				blur = heatmap.Blur.ValueInt64()
+			}
+
+			if !heatmap.Radius.IsNull() {
+				radius = heatmap.Radius.ValueInt64()
+			}
+
+			if !heatmap.WeightField.IsNull() {
+				weight.Field = heatmap.WeightField.ValueString()
+			}
+		}
+
+		layer.Config.Blur = &blur
+		layer.Config.Radius = &radius
+		layer.Config.Weight = weight
+	case "geojson":
+		layer.Config.Src = "public/maps/countries.geojson"
+
+		if !opts.GeoJsonURL.IsNull() {
+			layer.Config.Src = opts.GeoJsonURL.ValueString()
+		}
+
+		layer.Config.Style = style
+	case "route":
+		arrow := 0
+
+		switch opts.Arrow.ValueString() {
+		case "forward":
+			arrow = 1
+		case "reverse":
+			arrow = -1
+		}
+
+		layer.Config.Arrow = &arrow
+		layer.Config.Style = style
+	}
+
+	return layer
+}
+
+// geomapLocationFields are the fields each location mode needs to find the location of the data points.
+var geomapLocationFields = map[string][]string{
+	"coords":  {"latitude_field", "longitude_field"},
+	"geohash": {"geohash_field"},
+	"lookup":  {"lookup_field"},
+}
+
+// geomapLocationValidator checks that the fields needed by the location mode are set.
+type geomapLocationValidator struct{}
+
+func (v geomapLocationValidator) Description(_ context.Context) string {
+	return "The coords mode requires latitude_field and longitude_field, the geohash mode requires geohash_field, the lookup mode requires lookup_field."
+}
+
+func (v geomapLocationValidator) MarkdownDescription(_ context.Context) string {
+	return "The `coords` mode requires `latitude_field` and `longitude_field`, the `geohash` mode requires `geohash_field`, " +
+		"the `lookup` mode requires `lookup_field`."
+}
+
+func (v geomapLocationValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+
+	mode, ok := attributes["mode"].(types.String)
+	if !ok || mode.IsNull() || mode.IsUnknown() {
+		return
+	}
+
+	for _, field := range geomapLocationFields[mode.ValueString()] {
+		if value, ok := attributes[field].(types.String); ok && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(field),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("The attribute %q is required when the location mode is %q.", field, mode.ValueString()),
+			)
+		}
+	}
+}
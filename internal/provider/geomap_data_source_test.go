@@ -0,0 +1,619 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGeomapDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGeomapDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "json", testAccGeomapDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccGeomapDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "json", testAccGeomapDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccGeomapDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_geomap.test", "json", testAccGeomapDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccGeomapDataSourceViewConflictConfig,
+				ExpectError: regexp.MustCompile("Attribute \"view\\[0]\\.latitude\" cannot be specified when"),
+			},
+			{
+				Config:      testAccGeomapDataSourceLocationFieldsConfig,
+				ExpectError: regexp.MustCompile("The attribute \"longitude_field\" is required when the location mode is \"coords\""),
+			},
+		},
+	})
+}
+
+const testAccGeomapDataSourceViewConflictConfig = `
+data "gdashboard_geomap" "test" {
+  title = "Test"
+
+  view {
+    fit_to_data = true
+    latitude    = 52.37
+    longitude   = 4.89
+  }
+}
+`
+
+const testAccGeomapDataSourceLocationFieldsConfig = `
+data "gdashboard_geomap" "test" {
+  title = "Test"
+
+  layer {
+    type = "markers"
+
+    location {
+      mode           = "coords"
+      latitude_field = "lat"
+    }
+  }
+}
+`
+
+const testAccGeomapDataSourceConfig = `
+data "gdashboard_geomap" "test" {
+  title       = "Test"
+  description = "Geomap description"
+
+  field {
+    unit = "short"
+
+    color {
+      mode = "continuous-GrYlRd"
+    }
+  }
+
+  view {
+    latitude  = 52.52
+    longitude = 13.4
+    zoom      = 5
+  }
+
+  controls {
+    show_zoom        = false
+    mouse_wheel_zoom = false
+    show_scale       = true
+  }
+
+  base_layer {
+    type        = "carto"
+    theme       = "dark"
+    show_labels = false
+  }
+
+  layer {
+    type = "markers"
+    name = "Requests"
+
+    location {
+      mode            = "coords"
+      latitude_field  = "lat"
+      longitude_field = "lon"
+    }
+
+    style {
+      size_field = "Value"
+      size_min   = 3
+      size_max   = 20
+      color      = "red"
+      opacity    = 0.6
+      symbol     = "square"
+    }
+  }
+
+  layer {
+    type = "heatmap"
+
+    location {
+      mode          = "geohash"
+      geohash_field = "geohash"
+    }
+
+    heatmap {
+      blur         = 20
+      radius       = 10
+      weight_field = "Value"
+    }
+  }
+
+  layer {
+    type  = "route"
+    arrow = "forward"
+  }
+
+  tooltip {
+    mode = "none"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (lat, lon) (http_requests_total)"
+      ref_id  = "Prometheus_Query"
+      instant = true
+      format  = "table"
+    }
+  }
+}
+`
+
+const testAccGeomapDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Geomap description",
+  "transparent": false,
+  "type": "geomap",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum by (lat, lon) (http_requests_total)",
+      "instant": true,
+      "format": "table"
+    }
+  ],
+  "options": {
+    "view": {
+      "id": "coords",
+      "lat": 52.52,
+      "lon": 13.4,
+      "zoom": 5
+    },
+    "controls": {
+      "showZoom": false,
+      "mouseWheelZoom": false,
+      "showAttribution": true,
+      "showScale": true,
+      "showMeasure": false,
+      "showDebug": false
+    },
+    "basemap": {
+      "type": "carto",
+      "name": "Layer 0",
+      "config": {
+        "theme": "dark",
+        "showLabels": false
+      }
+    },
+    "layers": [
+      {
+        "type": "markers",
+        "name": "Requests",
+        "config": {
+          "showLegend": true,
+          "style": {
+            "size": {
+              "fixed": 5,
+              "min": 3,
+              "max": 20,
+              "field": "Value"
+            },
+            "color": {
+              "fixed": "red"
+            },
+            "opacity": 0.6,
+            "symbol": {
+              "mode": "fixed",
+              "fixed": "img/icons/marker/square.svg"
+            }
+          }
+        },
+        "location": {
+          "mode": "coords",
+          "latitude": "lat",
+          "longitude": "lon"
+        },
+        "tooltip": true
+      },
+      {
+        "type": "heatmap",
+        "name": "Layer 2",
+        "config": {
+          "blur": 20,
+          "radius": 10,
+          "weight": {
+            "fixed": 1,
+            "min": 0,
+            "max": 1,
+            "field": "Value"
+          }
+        },
+        "location": {
+          "mode": "geohash",
+          "geohash": "geohash"
+        },
+        "tooltip": true
+      },
+      {
+        "type": "route",
+        "name": "Layer 3",
+        "config": {
+          "style": {
+            "size": {
+              "fixed": 5,
+              "min": 2,
+              "max": 15
+            },
+            "color": {
+              "fixed": "dark-green"
+            },
+            "opacity": 0.4
+          },
+          "arrow": 1
+        },
+        "location": {
+          "mode": "auto"
+        },
+        "tooltip": true
+      }
+    ],
+    "tooltip": {
+      "mode": "none"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "short",
+      "color": {
+        "mode": "continuous-GrYlRd",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccGeomapDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    geomap {
+      field {
+        unit = "percent"
+      }
+
+      view {
+        fit_to_data = true
+        zoom        = 3
+      }
+
+      controls {
+        show_attribution = false
+        show_debug       = true
+        show_measure     = true
+      }
+
+      base_layer {
+        type        = "xyz"
+        url         = "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+        attribution = "OpenStreetMap"
+      }
+
+      tooltip {
+        mode = "none"
+      }
+    }
+  }
+}
+
+data "gdashboard_geomap" "test" {
+  title = "Test"
+
+  layer {
+    type = "geojson"
+  }
+}
+`
+
+const testAccGeomapDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "geomap",
+  "options": {
+    "view": {
+      "id": "fit",
+      "lat": 0,
+      "lon": 0,
+      "zoom": 3,
+      "allLayers": true
+    },
+    "controls": {
+      "showZoom": true,
+      "mouseWheelZoom": true,
+      "showAttribution": false,
+      "showScale": false,
+      "showMeasure": true,
+      "showDebug": true
+    },
+    "basemap": {
+      "type": "xyz",
+      "name": "Layer 0",
+      "config": {
+        "url": "https://tile.openstreetmap.org/{z}/{x}/{y}.png",
+        "attribution": "OpenStreetMap"
+      }
+    },
+    "layers": [
+      {
+        "type": "geojson",
+        "name": "Layer 1",
+        "config": {
+          "style": {
+            "size": {
+              "fixed": 5,
+              "min": 2,
+              "max": 15
+            },
+            "color": {
+              "fixed": "dark-green"
+            },
+            "opacity": 0.4
+          },
+          "src": "public/maps/countries.geojson"
+        },
+        "location": {
+          "mode": "auto"
+        },
+        "tooltip": true
+      }
+    ],
+    "tooltip": {
+      "mode": "none"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccGeomapDataSourceProviderDefaultsConfig = `
+data "gdashboard_geomap" "test" {
+  title = "Test"
+
+  layer {
+    type = "markers"
+  }
+}
+`
+
+const testAccGeomapDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "geomap",
+  "options": {
+    "view": {
+      "id": "zero",
+      "lat": 0,
+      "lon": 0,
+      "zoom": 1
+    },
+    "controls": {
+      "showZoom": true,
+      "mouseWheelZoom": true,
+      "showAttribution": true,
+      "showScale": false,
+      "showMeasure": false,
+      "showDebug": false
+    },
+    "basemap": {
+      "type": "default",
+      "name": "Layer 0"
+    },
+    "layers": [
+      {
+        "type": "markers",
+        "name": "Layer 1",
+        "config": {
+          "showLegend": true,
+          "style": {
+            "size": {
+              "fixed": 5,
+              "min": 2,
+              "max": 15
+            },
+            "color": {
+              "fixed": "dark-green"
+            },
+            "opacity": 0.4,
+            "symbol": {
+              "mode": "fixed",
+              "fixed": "img/icons/marker/circle.svg"
+            }
+          }
+        },
+        "location": {
+          "mode": "auto"
+        },
+        "tooltip": true
+      }
+    ],
+    "tooltip": {
+      "mode": "details"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -25,6 +25,20 @@ const (
 	HeatmapType
 	TimeseriesType
 	LogsType
+	PieChartType
+	StateTimelineType
+	StatusHistoryType
+	BarChartType
+	HistogramType
+	XYChartType
+	GeomapType
+	NodeGraphType
+	TracesType
+	CandlestickType
+	CanvasType
+	FlameGraphType
+	NewsType
+	AnnotationsListType
 )
 
 type (
@@ -48,6 +62,24 @@ type (
 		*TimeseriesPanel
 		*CustomPanel
 		*LogsPanel
+		*PieChartPanel
+		*StateTimelinePanel
+		*StatusHistoryPanel
+		*BarChartPanel
+		*HistogramPanel
+		*XYChartPanel
+		*GeomapPanel
+		*NodeGraphPanel
+		*TracesPanel
+		*CandlestickPanel
+		*CanvasPanel
+		*FlameGraphPanel
+		*NewsPanel
+		*AnnotationsListPanel
+
+		// source keeps the decoded JSON of the panel, so the keys the typed panel
+		// does not model survive the round-trip.
+		source map[string]interface{}
 	}
 	panelType int8
 	GridPos   struct {
@@ -303,27 +335,68 @@ type (
 		FieldConfig     FieldConfig `json:"fieldConfig"`
 	}
 	DashlistPanel struct {
-		Mode     string   `json:"mode"`
-		Query    string   `json:"query"`
-		Tags     []string `json:"tags"`
-		FolderID int      `json:"folderId"`
-		Limit    int      `json:"limit"`
-		Headings bool     `json:"headings"`
-		Recent   bool     `json:"recent"`
-		Search   bool     `json:"search"`
-		Starred  bool     `json:"starred"`
+		Mode     string           `json:"mode"`
+		Query    string           `json:"query"`
+		Tags     []string         `json:"tags"`
+		FolderID int              `json:"folderId"`
+		Limit    int              `json:"limit"`
+		Headings bool             `json:"headings"`
+		Recent   bool             `json:"recent"`
+		Search   bool             `json:"search"`
+		Starred  bool             `json:"starred"`
+		Options  *DashlistOptions `json:"options,omitempty"`
+	}
+	DashlistOptions struct {
+		ShowStarred        bool     `json:"showStarred"`
+		ShowRecentlyViewed bool     `json:"showRecentlyViewed"`
+		ShowSearch         bool     `json:"showSearch"`
+		ShowHeadings       bool     `json:"showHeadings"`
+		MaxItems           int64    `json:"maxItems"`
+		Query              string   `json:"query"`
+		Tags               []string `json:"tags"`
+		FolderUID          string   `json:"folderUID,omitempty"`
+		IncludeVars        bool     `json:"includeVars"`
+		KeepTime           bool     `json:"keepTime"`
 	}
 	PluginlistPanel struct {
 		Limit int `json:"limit,omitempty"`
 	}
 	AlertlistPanel struct {
-		OnlyAlertsOnDashboard bool     `json:"onlyAlertsOnDashboard"`
-		Show                  string   `json:"show"`
-		SortOrder             int      `json:"sortOrder"`
-		Limit                 int      `json:"limit"`
-		StateFilter           []string `json:"stateFilter"`
-		NameFilter            string   `json:"nameFilter,omitempty"`
-		DashboardTags         []string `json:"dashboardTags,omitempty"`
+		OnlyAlertsOnDashboard bool              `json:"onlyAlertsOnDashboard"`
+		Show                  string            `json:"show"`
+		SortOrder             int               `json:"sortOrder"`
+		Limit                 int               `json:"limit"`
+		StateFilter           []string          `json:"stateFilter"`
+		NameFilter            string            `json:"nameFilter,omitempty"`
+		DashboardTags         []string          `json:"dashboardTags,omitempty"`
+		Options               *AlertlistOptions `json:"options,omitempty"`
+	}
+	AlertlistOptions struct {
+		ViewMode                 string               `json:"viewMode"`
+		GroupMode                string               `json:"groupMode"`
+		GroupBy                  []string             `json:"groupBy"`
+		MaxItems                 int64                `json:"maxItems"`
+		SortOrder                int                  `json:"sortOrder"`
+		DashboardAlerts          bool                 `json:"dashboardAlerts"`
+		AlertName                string               `json:"alertName"`
+		AlertInstanceLabelFilter string               `json:"alertInstanceLabelFilter"`
+		Datasource               string               `json:"datasource,omitempty"`
+		Folder                   *AlertlistFolder     `json:"folder,omitempty"`
+		ShowInstances            bool                 `json:"showInstances"`
+		ShowInactiveAlerts       bool                 `json:"showInactiveAlerts"`
+		StateFilter              AlertlistStateFilter `json:"stateFilter"`
+	}
+	AlertlistFolder struct {
+		UID   string `json:"uid"`
+		Title string `json:"title,omitempty"`
+	}
+	AlertlistStateFilter struct {
+		Firing     bool `json:"firing"`
+		Pending    bool `json:"pending"`
+		Recovering bool `json:"recovering"`
+		NoData     bool `json:"noData"`
+		Normal     bool `json:"normal"`
+		Error      bool `json:"error"`
 	}
 	BarGaugePanel struct {
 		Options     Options     `json:"options"`
@@ -335,11 +408,16 @@ type (
 		Collapsed bool    `json:"collapsed"`
 	}
 	HeatmapPanel struct {
-		Cards struct {
+		Targets     []Target       `json:"targets,omitempty"`
+		Options     HeatmapOptions `json:"options"`
+		FieldConfig FieldConfig    `json:"fieldConfig"`
+
+		// The options of the legacy heatmap panel (before Grafana 9).
+		Cards *struct {
 			CardPadding *float64 `json:"cardPadding"`
 			CardRound   *float64 `json:"cardRound"`
-		} `json:"cards"`
-		Color struct {
+		} `json:"cards,omitempty"`
+		Color *struct {
 			CardColor   string   `json:"cardColor"`
 			ColorScale  string   `json:"colorScale"`
 			ColorScheme string   `json:"colorScheme"`
@@ -347,26 +425,25 @@ type (
 			Min         *float64 `json:"min,omitempty"`
 			Max         *float64 `json:"max,omitempty"`
 			Mode        string   `json:"mode"`
-		} `json:"color"`
-		DataFormat      string `json:"dataFormat"`
-		HideZeroBuckets bool   `json:"hideZeroBuckets"`
-		HighlightCards  bool   `json:"highlightCards"`
-		Legend          struct {
+		} `json:"color,omitempty"`
+		DataFormat      string `json:"dataFormat,omitempty"`
+		HideZeroBuckets bool   `json:"hideZeroBuckets,omitempty"`
+		HighlightCards  bool   `json:"highlightCards,omitempty"`
+		Legend          *struct {
 			Show bool `json:"show"`
-		} `json:"legend"`
-		ReverseYBuckets bool     `json:"reverseYBuckets"`
-		Targets         []Target `json:"targets,omitempty"`
-		Tooltip         struct {
+		} `json:"legend,omitempty"`
+		ReverseYBuckets bool `json:"reverseYBuckets,omitempty"`
+		Tooltip         *struct {
 			Show          bool `json:"show"`
 			ShowHistogram bool `json:"showHistogram"`
-		} `json:"tooltip"`
-		TooltipDecimals int `json:"tooltipDecimals"`
-		XAxis           struct {
+		} `json:"tooltip,omitempty"`
+		TooltipDecimals int `json:"tooltipDecimals,omitempty"`
+		XAxis           *struct {
 			Show bool `json:"show"`
-		} `json:"xAxis"`
-		XBucketNumber *float64 `json:"xBucketNumber"`
-		XBucketSize   *string  `json:"xBucketSize"`
-		YAxis         struct {
+		} `json:"xAxis,omitempty"`
+		XBucketNumber *float64 `json:"xBucketNumber,omitempty"`
+		XBucketSize   *string  `json:"xBucketSize,omitempty"`
+		YAxis         *struct {
 			Decimals    *int     `json:"decimals"`
 			Format      string   `json:"format"`
 			LogBase     int      `json:"logBase"`
@@ -374,10 +451,423 @@ type (
 			Max         *string  `json:"max"`
 			Min         *string  `json:"min"`
 			SplitFactor *float64 `json:"splitFactor"`
-		} `json:"yAxis"`
-		YBucketBound  string   `json:"yBucketBound"`
-		YBucketNumber *float64 `json:"yBucketNumber"`
-		YBucketSize   *float64 `json:"yBucketSize"`
+		} `json:"yAxis,omitempty"`
+		YBucketBound  string   `json:"yBucketBound,omitempty"`
+		YBucketNumber *float64 `json:"yBucketNumber,omitempty"`
+		YBucketSize   *float64 `json:"yBucketSize,omitempty"`
+	}
+	HeatmapOptions struct {
+		Calculate   bool                    `json:"calculate"`
+		Calculation *HeatmapCalculation     `json:"calculation,omitempty"`
+		CellGap     int                     `json:"cellGap"`
+		Color       HeatmapColorOptions     `json:"color"`
+		Exemplars   HeatmapExemplarsOptions `json:"exemplars"`
+		Legend      HeatmapLegendOptions    `json:"legend"`
+		RowsFrame   HeatmapRowsFrameOptions `json:"rowsFrame"`
+		ShowValue   string                  `json:"showValue"`
+		Tooltip     HeatmapTooltipOptions   `json:"tooltip"`
+		YAxis       HeatmapYAxisOptions     `json:"yAxis"`
+	}
+	HeatmapCalculation struct {
+		XBuckets *HeatmapCalculationBuckets `json:"xBuckets,omitempty"`
+		YBuckets *HeatmapCalculationBuckets `json:"yBuckets,omitempty"`
+	}
+	HeatmapCalculationBuckets struct {
+		Mode  string                   `json:"mode,omitempty"`
+		Value string                   `json:"value,omitempty"`
+		Scale *HeatmapCalculationScale `json:"scale,omitempty"`
+	}
+	HeatmapCalculationScale struct {
+		Type string `json:"type"`
+		Log  int    `json:"log,omitempty"`
+	}
+	HeatmapColorOptions struct {
+		Mode     string   `json:"mode"`
+		Scheme   string   `json:"scheme"`
+		Fill     string   `json:"fill"`
+		Scale    string   `json:"scale"`
+		Exponent float64  `json:"exponent"`
+		Steps    int      `json:"steps"`
+		Reverse  bool     `json:"reverse"`
+		Min      *float64 `json:"min,omitempty"`
+		Max      *float64 `json:"max,omitempty"`
+	}
+	HeatmapExemplarsOptions struct {
+		Color string `json:"color"`
+	}
+	HeatmapLegendOptions struct {
+		Show bool `json:"show"`
+	}
+	HeatmapRowsFrameOptions struct {
+		Layout string `json:"layout"`
+	}
+	HeatmapTooltipOptions struct {
+		Show       bool `json:"show"`
+		YHistogram bool `json:"yHistogram"`
+	}
+	HeatmapYAxisOptions struct {
+		AxisPlacement string `json:"axisPlacement"`
+		Reverse       bool   `json:"reverse"`
+	}
+	PieChartPanel struct {
+		Targets     []Target        `json:"targets,omitempty"`
+		Options     PieChartOptions `json:"options"`
+		FieldConfig FieldConfig     `json:"fieldConfig"`
+	}
+	PieChartOptions struct {
+		PieType       string                   `json:"pieType"`
+		DisplayLabels []string                 `json:"displayLabels"`
+		Legend        PieChartLegendOptions    `json:"legend"`
+		Tooltip       TimeseriesTooltipOptions `json:"tooltip"`
+		ReduceOptions ReduceOptions            `json:"reduceOptions"`
+	}
+	PieChartLegendOptions struct {
+		DisplayMode string   `json:"displayMode"`
+		Placement   string   `json:"placement"`
+		ShowLegend  bool     `json:"showLegend"`
+		Values      []string `json:"values"`
+	}
+	StateTimelinePanel struct {
+		Targets     []Target             `json:"targets,omitempty"`
+		Options     StateTimelineOptions `json:"options"`
+		FieldConfig FieldConfig          `json:"fieldConfig"`
+	}
+	StateTimelineOptions struct {
+		MergeValues bool                     `json:"mergeValues"`
+		ShowValue   string                   `json:"showValue"`
+		AlignValue  string                   `json:"alignValue"`
+		RowHeight   float64                  `json:"rowHeight"`
+		Legend      VizLegendOptions         `json:"legend"`
+		Tooltip     TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	VizLegendOptions struct {
+		Calcs       []string `json:"calcs"`
+		DisplayMode string   `json:"displayMode"`
+		Placement   string   `json:"placement"`
+		ShowLegend  bool     `json:"showLegend"`
+	}
+	StatusHistoryPanel struct {
+		Targets     []Target             `json:"targets,omitempty"`
+		Options     StatusHistoryOptions `json:"options"`
+		FieldConfig FieldConfig          `json:"fieldConfig"`
+	}
+	StatusHistoryOptions struct {
+		ShowValue string                   `json:"showValue"`
+		RowHeight float64                  `json:"rowHeight"`
+		ColWidth  float64                  `json:"colWidth"`
+		Legend    VizLegendOptions         `json:"legend"`
+		Tooltip   TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	BarChartPanel struct {
+		Targets     []Target        `json:"targets,omitempty"`
+		Options     BarChartOptions `json:"options"`
+		FieldConfig FieldConfig     `json:"fieldConfig"`
+	}
+	BarChartOptions struct {
+		Orientation        string                   `json:"orientation"`
+		XField             string                   `json:"xField,omitempty"`
+		GroupWidth         float64                  `json:"groupWidth"`
+		BarWidth           float64                  `json:"barWidth"`
+		BarRadius          float64                  `json:"barRadius"`
+		Stacking           string                   `json:"stacking"`
+		ShowValue          string                   `json:"showValue"`
+		XTickLabelRotation int                      `json:"xTickLabelRotation"`
+		Legend             VizLegendOptions         `json:"legend"`
+		Tooltip            TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	HistogramPanel struct {
+		Targets     []Target         `json:"targets,omitempty"`
+		Options     HistogramOptions `json:"options"`
+		FieldConfig FieldConfig      `json:"fieldConfig"`
+	}
+	HistogramOptions struct {
+		BucketSize   *float64                 `json:"bucketSize,omitempty"`
+		BucketOffset *float64                 `json:"bucketOffset,omitempty"`
+		BucketCount  *int64                   `json:"bucketCount,omitempty"`
+		Combine      bool                     `json:"combine"`
+		Legend       VizLegendOptions         `json:"legend"`
+		Tooltip      TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	XYChartPanel struct {
+		Targets     []Target       `json:"targets,omitempty"`
+		Options     XYChartOptions `json:"options"`
+		FieldConfig FieldConfig    `json:"fieldConfig"`
+	}
+	XYChartOptions struct {
+		SeriesMapping string                   `json:"seriesMapping"`
+		Dims          XYChartDims              `json:"dims"`
+		Series        []XYChartSeries          `json:"series"`
+		Legend        VizLegendOptions         `json:"legend"`
+		Tooltip       TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	XYChartDims struct {
+		Frame   int      `json:"frame"`
+		X       string   `json:"x,omitempty"`
+		Exclude []string `json:"exclude,omitempty"`
+	}
+	XYChartSeries struct {
+		Name       string            `json:"name,omitempty"`
+		X          string            `json:"x,omitempty"`
+		Y          string            `json:"y,omitempty"`
+		PointColor XYChartPointColor `json:"pointColor"`
+		PointSize  XYChartPointSize  `json:"pointSize"`
+	}
+	XYChartPointColor struct {
+		Field string `json:"field,omitempty"`
+		Fixed string `json:"fixed,omitempty"`
+	}
+	XYChartPointSize struct {
+		Field string `json:"field,omitempty"`
+		Fixed int    `json:"fixed"`
+		Min   int    `json:"min"`
+		Max   int    `json:"max"`
+	}
+	GeomapPanel struct {
+		Targets     []Target      `json:"targets,omitempty"`
+		Options     GeomapOptions `json:"options"`
+		FieldConfig FieldConfig   `json:"fieldConfig"`
+	}
+	GeomapOptions struct {
+		View     GeomapView        `json:"view"`
+		Controls GeomapControls    `json:"controls"`
+		Basemap  GeomapBaseLayer   `json:"basemap"`
+		Layers   []GeomapDataLayer `json:"layers"`
+		Tooltip  GeomapTooltip     `json:"tooltip"`
+	}
+	GeomapView struct {
+		ID        string  `json:"id"`
+		Lat       float64 `json:"lat"`
+		Lon       float64 `json:"lon"`
+		Zoom      float64 `json:"zoom"`
+		AllLayers bool    `json:"allLayers,omitempty"`
+	}
+	GeomapControls struct {
+		ShowZoom        bool `json:"showZoom"`
+		MouseWheelZoom  bool `json:"mouseWheelZoom"`
+		ShowAttribution bool `json:"showAttribution"`
+		ShowScale       bool `json:"showScale"`
+		ShowMeasure     bool `json:"showMeasure"`
+		ShowDebug       bool `json:"showDebug"`
+	}
+	GeomapBaseLayer struct {
+		Type   string                 `json:"type"`
+		Name   string                 `json:"name"`
+		Config *GeomapBaseLayerConfig `json:"config,omitempty"`
+	}
+	GeomapBaseLayerConfig struct {
+		Theme       string `json:"theme,omitempty"`
+		ShowLabels  *bool  `json:"showLabels,omitempty"`
+		URL         string `json:"url,omitempty"`
+		Attribution string `json:"attribution,omitempty"`
+	}
+	GeomapDataLayer struct {
+		Type     string                `json:"type"`
+		Name     string                `json:"name"`
+		Config   GeomapDataLayerConfig `json:"config"`
+		Location GeomapLocation        `json:"location"`
+		Tooltip  bool                  `json:"tooltip"`
+	}
+	GeomapDataLayerConfig struct {
+		ShowLegend *bool           `json:"showLegend,omitempty"`
+		Style      *GeomapStyle    `json:"style,omitempty"`
+		Blur       *int64          `json:"blur,omitempty"`
+		Radius     *int64          `json:"radius,omitempty"`
+		Weight     *ScaleDimension `json:"weight,omitempty"`
+		Src        string          `json:"src,omitempty"`
+		Arrow      *int            `json:"arrow,omitempty"`
+	}
+	GeomapStyle struct {
+		Size    ScaleDimension     `json:"size"`
+		Color   ColorDimension     `json:"color"`
+		Opacity float64            `json:"opacity"`
+		Symbol  *ResourceDimension `json:"symbol,omitempty"`
+	}
+	ScaleDimension struct {
+		Fixed float64 `json:"fixed"`
+		Min   float64 `json:"min"`
+		Max   float64 `json:"max"`
+		Field string  `json:"field,omitempty"`
+	}
+	ColorDimension struct {
+		Fixed string `json:"fixed"`
+		Field string `json:"field,omitempty"`
+	}
+	ResourceDimension struct {
+		Mode  string `json:"mode"`
+		Fixed string `json:"fixed"`
+		Field string `json:"field,omitempty"`
+	}
+	GeomapLocation struct {
+		Mode      string `json:"mode"`
+		Latitude  string `json:"latitude,omitempty"`
+		Longitude string `json:"longitude,omitempty"`
+		Geohash   string `json:"geohash,omitempty"`
+		Lookup    string `json:"lookup,omitempty"`
+		Gazetteer string `json:"gazetteer,omitempty"`
+	}
+	GeomapTooltip struct {
+		Mode string `json:"mode"`
+	}
+	NodeGraphPanel struct {
+		Targets []Target         `json:"targets,omitempty"`
+		Options NodeGraphOptions `json:"options"`
+	}
+	NodeGraphOptions struct {
+		Nodes NodeGraphNodeOptions `json:"nodes"`
+		Edges NodeGraphEdgeOptions `json:"edges"`
+	}
+	NodeGraphNodeOptions struct {
+		MainStatUnit      string               `json:"mainStatUnit,omitempty"`
+		SecondaryStatUnit string               `json:"secondaryStatUnit,omitempty"`
+		Arcs              []NodeGraphArcOption `json:"arcs"`
+	}
+	NodeGraphEdgeOptions struct {
+		MainStatUnit      string `json:"mainStatUnit,omitempty"`
+		SecondaryStatUnit string `json:"secondaryStatUnit,omitempty"`
+	}
+	NodeGraphArcOption struct {
+		Field string `json:"field"`
+		Color string `json:"color"`
+	}
+	TracesPanel struct {
+		Targets []Target `json:"targets,omitempty"`
+	}
+	CandlestickPanel struct {
+		Targets     []Target           `json:"targets,omitempty"`
+		Options     CandlestickOptions `json:"options"`
+		FieldConfig FieldConfig        `json:"fieldConfig"`
+	}
+	CandlestickOptions struct {
+		Mode             string                   `json:"mode"`
+		CandleStyle      string                   `json:"candleStyle"`
+		ColorStrategy    string                   `json:"colorStrategy"`
+		Colors           CandlestickColors        `json:"colors"`
+		Fields           CandlestickFieldMap      `json:"fields"`
+		IncludeAllFields bool                     `json:"includeAllFields"`
+		Legend           VizLegendOptions         `json:"legend"`
+		Tooltip          TimeseriesTooltipOptions `json:"tooltip"`
+	}
+	CandlestickColors struct {
+		Up   string `json:"up"`
+		Down string `json:"down"`
+	}
+	CandlestickFieldMap struct {
+		Open   string `json:"open,omitempty"`
+		High   string `json:"high,omitempty"`
+		Low    string `json:"low,omitempty"`
+		Close  string `json:"close,omitempty"`
+		Volume string `json:"volume,omitempty"`
+	}
+	CanvasPanel struct {
+		Targets     []Target      `json:"targets,omitempty"`
+		Options     CanvasOptions `json:"options"`
+		FieldConfig FieldConfig   `json:"fieldConfig"`
+	}
+	CanvasOptions struct {
+		InlineEditing     bool        `json:"inlineEditing"`
+		ShowAdvancedTypes bool        `json:"showAdvancedTypes"`
+		PanZoom           bool        `json:"panZoom"`
+		Root              CanvasFrame `json:"root"`
+	}
+	CanvasFrame struct {
+		Type       string           `json:"type"`
+		Name       string           `json:"name"`
+		Elements   []CanvasElement  `json:"elements"`
+		Background CanvasBackground `json:"background"`
+		Border     CanvasBorder     `json:"border"`
+		Constraint CanvasConstraint `json:"constraint"`
+	}
+	CanvasElement struct {
+		Type        string              `json:"type"`
+		Name        string              `json:"name"`
+		Config      CanvasElementConfig `json:"config"`
+		Background  CanvasBackground    `json:"background"`
+		Border      CanvasBorder        `json:"border"`
+		Placement   CanvasPlacement     `json:"placement"`
+		Constraint  CanvasConstraint    `json:"constraint"`
+		Connections []CanvasConnection  `json:"connections,omitempty"`
+	}
+	CanvasElementConfig struct {
+		Text      *TextDimension     `json:"text,omitempty"`
+		Color     *ColorDimension    `json:"color,omitempty"`
+		Size      int64              `json:"size,omitempty"`
+		Align     string             `json:"align,omitempty"`
+		VAlign    string             `json:"valign,omitempty"`
+		Path      *ResourceDimension `json:"path,omitempty"`
+		Fill      *ColorDimension    `json:"fill,omitempty"`
+		Type      string             `json:"type,omitempty"`
+		BulbColor *ColorDimension    `json:"bulbColor,omitempty"`
+		BlinkRate *ScalarDimension   `json:"blinkRate,omitempty"`
+	}
+	CanvasBackground struct {
+		Color ColorDimension     `json:"color"`
+		Image *ResourceDimension `json:"image,omitempty"`
+		Size  string             `json:"size,omitempty"`
+	}
+	CanvasBorder struct {
+		Color  ColorDimension `json:"color"`
+		Width  int64          `json:"width,omitempty"`
+		Radius int64          `json:"radius,omitempty"`
+	}
+	CanvasPlacement struct {
+		Top      float64 `json:"top"`
+		Left     float64 `json:"left"`
+		Width    float64 `json:"width"`
+		Height   float64 `json:"height"`
+		Rotation float64 `json:"rotation"`
+	}
+	CanvasConstraint struct {
+		Horizontal string `json:"horizontal"`
+		Vertical   string `json:"vertical"`
+	}
+	CanvasConnection struct {
+		Source     CanvasConnectionCoordinates `json:"source"`
+		Target     CanvasConnectionCoordinates `json:"target"`
+		TargetName string                      `json:"targetName,omitempty"`
+		Color      ColorDimension              `json:"color"`
+		Size       ScaleDimension              `json:"size"`
+		Path       string                      `json:"path"`
+	}
+	CanvasConnectionCoordinates struct {
+		X float64 `json:"x"`
+		Y float64 `json:"y"`
+	}
+	TextDimension struct {
+		Mode  string `json:"mode"`
+		Fixed string `json:"fixed"`
+		Field string `json:"field,omitempty"`
+	}
+	ScalarDimension struct {
+		Fixed float64 `json:"fixed"`
+		Min   float64 `json:"min"`
+		Max   float64 `json:"max"`
+		Field string  `json:"field,omitempty"`
+	}
+	FlameGraphPanel struct {
+		Targets []Target `json:"targets,omitempty"`
+	}
+	NewsPanelOptions struct {
+		FeedURL   string `json:"feedUrl"`
+		ShowImage bool   `json:"showImage"`
+		UseProxy  bool   `json:"useProxy"`
+	}
+	NewsPanel struct {
+		Options NewsPanelOptions `json:"options"`
+	}
+	AnnotationsListPanelOptions struct {
+		OnlyFromThisDashboard bool     `json:"onlyFromThisDashboard"`
+		OnlyInTimeRange       bool     `json:"onlyInTimeRange"`
+		Tags                  []string `json:"tags"`
+		Limit                 int64    `json:"limit"`
+		ShowUser              bool     `json:"showUser"`
+		ShowTime              bool     `json:"showTime"`
+		ShowTags              bool     `json:"showTags"`
+		NavigateToPanel       bool     `json:"navigateToPanel"`
+		NavigateBefore        string   `json:"navigateBefore"`
+		NavigateAfter         string   `json:"navigateAfter"`
+	}
+	AnnotationsListPanel struct {
+		Options AnnotationsListPanelOptions `json:"options"`
 	}
 	TimeseriesPanel struct {
 		Targets     []Target          `json:"targets,omitempty"`
@@ -408,6 +898,15 @@ type (
 		Mappings   []FieldMapping    `json:"mappings,omitempty"`
 		//Links      []Link            `json:"links,omitempty"`
 	}
+	CustomFieldConfig struct {
+		Defaults  CustomFieldConfigDefaults `json:"defaults"`
+		Overrides []FieldOverride           `json:"overrides,omitempty"`
+	}
+	// CustomFieldConfigDefaults replaces the typed custom options with arbitrary ones.
+	CustomFieldConfigDefaults struct {
+		FieldConfigDefaults
+		Custom interface{} `json:"custom"`
+	}
 	FieldMapping struct {
 		Type    string                 `json:"type"`
 		Options map[string]interface{} `json:"options"`
@@ -426,6 +925,7 @@ type (
 		PointSize         int      `json:"pointSize"`
 		ShowPoints        string   `json:"showPoints"`
 		SpanNulls         bool     `json:"spanNulls"`
+		Show              string   `json:"show,omitempty"`
 		HideFrom          struct {
 			Legend  bool `json:"legend"`
 			Tooltip bool `json:"tooltip"`
@@ -584,18 +1084,22 @@ type Target struct {
 	Datasource interface{} `json:"datasource,omitempty"`
 	Hide       bool        `json:"hide,omitempty"`
 	Type       string      `json:"type,omitempty"`
+	QueryType  string      `json:"queryType,omitempty"`
 
 	// For Prometheus
-	Expr           string `json:"expr,omitempty"`
-	IntervalFactor int    `json:"intervalFactor,omitempty"`
-	Interval       string `json:"interval,omitempty"`
-	Step           int    `json:"step,omitempty"`
-	LegendFormat   string `json:"legendFormat,omitempty"`
-	Instant        bool   `json:"instant,omitempty"`
-	Format         string `json:"format,omitempty"`
+	Expr           string      `json:"expr,omitempty"`
+	IntervalFactor int         `json:"intervalFactor,omitempty"`
+	Interval       string      `json:"interval,omitempty"`
+	Step           interface{} `json:"step,omitempty"` // int for Prometheus, string for Loki
+	LegendFormat   string      `json:"legendFormat,omitempty"`
+	Instant        bool        `json:"instant,omitempty"`
+	Format         string      `json:"format,omitempty"`
 
 	// For Graphite
-	Target string `json:"target,omitempty"`
+	Target     string `json:"target,omitempty"`
+	TargetFull string `json:"targetFull,omitempty"`
+	RefCount   int    `json:"refCount,omitempty"`
+	TextEditor bool   `json:"textEditor,omitempty"`
 
 	// For CloudWatch
 	QueryMode        string               `json:"queryMode,omitempty"`
@@ -612,12 +1116,81 @@ type Target struct {
 	Region           string               `json:"region,omitempty"`
 	Label            string               `json:"label,omitempty"`
 
+	// For Loki
+	MaxLines   *int64 `json:"maxLines,omitempty"`
+	Resolution *int64 `json:"resolution,omitempty"`
+	Direction  string `json:"direction,omitempty"`
+
+	// For Elasticsearch and OpenSearch
+	Query      string                   `json:"query,omitempty"`
+	Alias      string                   `json:"alias,omitempty"`
+	TimeField  string                   `json:"timeField,omitempty"`
+	Metrics    []ElasticsearchMetric    `json:"metrics,omitempty"`
+	BucketAggs []ElasticsearchBucketAgg `json:"bucketAggs,omitempty"`
+
+	// For InfluxDB and SQL
+	RawQuery     bool   `json:"rawQuery,omitempty"`
+	ResultFormat string `json:"resultFormat,omitempty"`
+	RawSQL       string `json:"rawSql,omitempty"`
+	EditorMode   string `json:"editorMode,omitempty"`
+
+	// For Tempo and Jaeger
+	Limit                      *int64 `json:"limit,omitempty"`
+	SpansPerSpanSet            *int64 `json:"spss,omitempty"`
+	TableType                  string `json:"tableType,omitempty"`
+	MetricsQueryType           string `json:"metricsQueryType,omitempty"`
+	ServiceMapQuery            string `json:"serviceMapQuery,omitempty"`
+	ServiceMapIncludeNamespace bool   `json:"serviceMapIncludeNamespace,omitempty"`
+	Service                    string `json:"service,omitempty"`
+	Operation                  string `json:"operation,omitempty"`
+	Tags                       string `json:"tags,omitempty"`
+	MinDuration                string `json:"minDuration,omitempty"`
+	MaxDuration                string `json:"maxDuration,omitempty"`
+
+	// For Azure Monitor
+	Subscription       string                   `json:"subscription,omitempty"`
+	Subscriptions      []string                 `json:"subscriptions,omitempty"`
+	AzureMonitor       *AzureMonitorQuery       `json:"azureMonitor,omitempty"`
+	AzureLogAnalytics  *AzureLogAnalyticsQuery  `json:"azureLogAnalytics,omitempty"`
+	AzureResourceGraph *AzureResourceGraphQuery `json:"azureResourceGraph,omitempty"`
+
+	// For Google Cloud Monitoring
+	AliasBy         string                          `json:"aliasBy,omitempty"`
+	TimeSeriesList  *CloudMonitoringTimeSeriesList  `json:"timeSeriesList,omitempty"`
+	TimeSeriesQuery *CloudMonitoringTimeSeriesQuery `json:"timeSeriesQuery,omitempty"`
+	PromQLQuery     *CloudMonitoringPromQLQuery     `json:"promQLQuery,omitempty"`
+
+	// For TestData
+	ScenarioID      string             `json:"scenarioId,omitempty"`
+	Labels          string             `json:"labels,omitempty"`
+	SeriesCount     *int64             `json:"seriesCount,omitempty"`
+	Seed            *int64             `json:"seed,omitempty"`
+	Min             *float64           `json:"min,omitempty"`
+	Max             *float64           `json:"max,omitempty"`
+	StartValue      *float64           `json:"startValue,omitempty"`
+	Spread          *float64           `json:"spread,omitempty"`
+	Noise           *float64           `json:"noise,omitempty"`
+	Drop            *float64           `json:"drop,omitempty"`
+	CSVContent      string             `json:"csvContent,omitempty"`
+	StringInput     string             `json:"stringInput,omitempty"`
+	PulseWave       *TestDataPulseWave `json:"pulseWave,omitempty"`
+	Lines           *int64             `json:"lines,omitempty"`
+	LevelColumn     bool               `json:"levelColumn,omitempty"`
+	RawFrameContent string             `json:"rawFrameContent,omitempty"`
+
+	// For Pyroscope
+	ProfileTypeID string   `json:"profileTypeId,omitempty"`
+	LabelSelector string   `json:"labelSelector,omitempty"`
+	GroupBy       []string `json:"groupBy,omitempty"`
+	MaxNodes      *int64   `json:"maxNodes,omitempty"`
+
 	// For Expression
 	Reducer     *string                   `json:"reducer,omitempty"`
 	Downsampler *string                   `json:"downsampler,omitempty"`
 	Upsampler   *string                   `json:"upsampler,omitempty"`
 	Window      *string                   `json:"window,omitempty"`
 	Settings    *ReduceExpressionSettings `json:"settings,omitempty"`
+	Conditions  []ExpressionCondition     `json:"conditions,omitempty"`
 }
 
 type ReduceExpressionSettings struct {
@@ -625,6 +1198,137 @@ type ReduceExpressionSettings struct {
 	ReplaceWithValue *float64 `json:"replaceWithValue,omitempty"`
 }
 
+type ExpressionCondition struct {
+	Type            string                        `json:"type,omitempty"`
+	Query           *ExpressionConditionQuery     `json:"query,omitempty"`
+	Reducer         *ExpressionConditionReducer   `json:"reducer,omitempty"`
+	Evaluator       ExpressionConditionEvaluator  `json:"evaluator"`
+	UnloadEvaluator *ExpressionConditionEvaluator `json:"unloadEvaluator,omitempty"`
+	Operator        *ExpressionConditionOperator  `json:"operator,omitempty"`
+}
+
+type ExpressionConditionQuery struct {
+	Params []string `json:"params"`
+}
+
+type ExpressionConditionReducer struct {
+	Type   string    `json:"type"`
+	Params []float64 `json:"params"`
+}
+
+type ExpressionConditionEvaluator struct {
+	Type   string    `json:"type"`
+	Params []float64 `json:"params"`
+}
+
+type ExpressionConditionOperator struct {
+	Type string `json:"type"`
+}
+
+type ElasticsearchMetric struct {
+	ID       string                       `json:"id"`
+	Type     string                       `json:"type"`
+	Field    string                       `json:"field,omitempty"`
+	Hide     bool                         `json:"hide,omitempty"`
+	Settings *ElasticsearchMetricSettings `json:"settings,omitempty"`
+}
+
+type ElasticsearchMetricSettings struct {
+	Percents []string `json:"percents,omitempty"`
+	Size     string   `json:"size,omitempty"`
+	Limit    string   `json:"limit,omitempty"`
+}
+
+type ElasticsearchBucketAgg struct {
+	ID       string                          `json:"id"`
+	Type     string                          `json:"type"`
+	Field    string                          `json:"field,omitempty"`
+	Settings *ElasticsearchBucketAggSettings `json:"settings,omitempty"`
+}
+
+type ElasticsearchBucketAggSettings struct {
+	Interval    string                `json:"interval,omitempty"`
+	MinDocCount string                `json:"min_doc_count,omitempty"`
+	TrimEdges   string                `json:"trimEdges,omitempty"`
+	Offset      string                `json:"offset,omitempty"`
+	Size        string                `json:"size,omitempty"`
+	Order       string                `json:"order,omitempty"`
+	OrderBy     string                `json:"orderBy,omitempty"`
+	Precision   string                `json:"precision,omitempty"`
+	Filters     []ElasticsearchFilter `json:"filters,omitempty"`
+}
+
+type ElasticsearchFilter struct {
+	Query string `json:"query"`
+	Label string `json:"label"`
+}
+
+type TestDataPulseWave struct {
+	TimeStep int64   `json:"timeStep"`
+	OnCount  int64   `json:"onCount"`
+	OnValue  float64 `json:"onValue"`
+	OffCount int64   `json:"offCount"`
+	OffValue float64 `json:"offValue"`
+}
+
+type AzureMonitorQuery struct {
+	Resources        []AzureMonitorResource        `json:"resources"`
+	MetricNamespace  string                        `json:"metricNamespace"`
+	MetricName       string                        `json:"metricName"`
+	Aggregation      string                        `json:"aggregation,omitempty"`
+	TimeGrain        string                        `json:"timeGrain,omitempty"`
+	DimensionFilters []AzureMonitorDimensionFilter `json:"dimensionFilters,omitempty"`
+	Top              string                        `json:"top,omitempty"`
+	Alias            string                        `json:"alias,omitempty"`
+}
+
+type AzureMonitorResource struct {
+	Subscription    string `json:"subscription"`
+	ResourceGroup   string `json:"resourceGroup"`
+	MetricNamespace string `json:"metricNamespace"`
+	ResourceName    string `json:"resourceName"`
+	Region          string `json:"region,omitempty"`
+}
+
+type AzureMonitorDimensionFilter struct {
+	Dimension string   `json:"dimension"`
+	Operator  string   `json:"operator"`
+	Filters   []string `json:"filters"`
+}
+
+type AzureLogAnalyticsQuery struct {
+	Query         string   `json:"query"`
+	Resources     []string `json:"resources"`
+	ResultFormat  string   `json:"resultFormat"`
+	DashboardTime bool     `json:"dashboardTime,omitempty"`
+	TimeColumn    string   `json:"timeColumn,omitempty"`
+}
+
+type AzureResourceGraphQuery struct {
+	Query        string `json:"query"`
+	ResultFormat string `json:"resultFormat"`
+}
+
+type CloudMonitoringTimeSeriesList struct {
+	ProjectName        string   `json:"projectName"`
+	Filters            []string `json:"filters"`
+	PerSeriesAligner   string   `json:"perSeriesAligner"`
+	CrossSeriesReducer string   `json:"crossSeriesReducer"`
+	AlignmentPeriod    string   `json:"alignmentPeriod"`
+	GroupBys           []string `json:"groupBys,omitempty"`
+}
+
+type CloudMonitoringTimeSeriesQuery struct {
+	ProjectName string `json:"projectName"`
+	Query       string `json:"query"`
+}
+
+type CloudMonitoringPromQLQuery struct {
+	ProjectName string `json:"projectName"`
+	Expr        string `json:"expr"`
+	Step        string `json:"step,omitempty"`
+}
+
 type CloudWatchLogGroup struct {
 	Arn       string `json:"arn,omitempty"`
 	Name      string `json:"name,omitempty"`
@@ -695,6 +1399,14 @@ func (p *Panel) UnmarshalJSON(b []byte) (err error) {
 		p.OfType = DashlistType
 		if err = json.Unmarshal(b, &dashlist); err == nil {
 			p.DashlistPanel = &dashlist
+			p.source, err = decodeSource(b)
+		}
+	case "alertlist":
+		var alertlist AlertlistPanel
+		p.OfType = AlertlistType
+		if err = json.Unmarshal(b, &alertlist); err == nil {
+			p.AlertlistPanel = &alertlist
+			p.source, err = decodeSource(b)
 		}
 	case "bargauge":
 		var bargauge BarGaugePanel
@@ -713,6 +1425,7 @@ func (p *Panel) UnmarshalJSON(b []byte) (err error) {
 		p.OfType = HeatmapType
 		if err = json.Unmarshal(b, &heatmap); err == nil {
 			p.HeatmapPanel = &heatmap
+			p.source, err = decodeSource(b)
 		}
 	case "timeseries":
 		var timeseries TimeseriesPanel
@@ -732,6 +1445,27 @@ func (p *Panel) UnmarshalJSON(b []byte) (err error) {
 		if err = json.Unmarshal(b, &logs); err == nil {
 			p.LogsPanel = &logs
 		}
+	case "xychart":
+		var xychart XYChartPanel
+		p.OfType = XYChartType
+		if err = json.Unmarshal(b, &xychart); err == nil {
+			p.XYChartPanel = &xychart
+			p.source, err = decodeSource(b)
+		}
+	case "nodeGraph":
+		var nodeGraph NodeGraphPanel
+		p.OfType = NodeGraphType
+		if err = json.Unmarshal(b, &nodeGraph); err == nil {
+			p.NodeGraphPanel = &nodeGraph
+			p.source, err = decodeSource(b)
+		}
+	case "traces":
+		var traces TracesPanel
+		p.OfType = TracesType
+		if err = json.Unmarshal(b, &traces); err == nil {
+			p.TracesPanel = &traces
+			p.source, err = decodeSource(b)
+		}
 	default:
 		var custom = make(CustomPanel)
 		p.OfType = CustomType
@@ -784,7 +1518,7 @@ func (p *Panel) MarshalJSON() ([]byte, error) {
 			CommonPanel
 			DashlistPanel
 		}{p.CommonPanel, *p.DashlistPanel}
-		return json.Marshal(outDashlist)
+		return marshalWithSource(p.CommonPanel, outDashlist, p.source)
 	case BarGaugeType:
 		var outBarGauge = struct {
 			CommonPanel
@@ -808,7 +1542,7 @@ func (p *Panel) MarshalJSON() ([]byte, error) {
 			CommonPanel
 			AlertlistPanel
 		}{p.CommonPanel, *p.AlertlistPanel}
-		return json.Marshal(outAlertlist)
+		return marshalWithSource(p.CommonPanel, outAlertlist, p.source)
 	case RowType:
 		var outRow = struct {
 			CommonPanel
@@ -820,7 +1554,7 @@ func (p *Panel) MarshalJSON() ([]byte, error) {
 			CommonPanel
 			HeatmapPanel
 		}{p.CommonPanel, *p.HeatmapPanel}
-		return json.Marshal(outHeatmap)
+		return marshalWithSource(p.CommonPanel, outHeatmap, p.source)
 	case TimeseriesType:
 		var outTimeseries = struct {
 			CommonPanel
@@ -839,6 +1573,90 @@ func (p *Panel) MarshalJSON() ([]byte, error) {
 			LogsPanel
 		}{p.CommonPanel, *p.LogsPanel}
 		return json.Marshal(outLogs)
+	case PieChartType:
+		var outPieChart = struct {
+			CommonPanel
+			PieChartPanel
+		}{p.CommonPanel, *p.PieChartPanel}
+		return json.Marshal(outPieChart)
+	case StateTimelineType:
+		var outStateTimeline = struct {
+			CommonPanel
+			StateTimelinePanel
+		}{p.CommonPanel, *p.StateTimelinePanel}
+		return json.Marshal(outStateTimeline)
+	case StatusHistoryType:
+		var outStatusHistory = struct {
+			CommonPanel
+			StatusHistoryPanel
+		}{p.CommonPanel, *p.StatusHistoryPanel}
+		return json.Marshal(outStatusHistory)
+	case BarChartType:
+		var outBarChart = struct {
+			CommonPanel
+			BarChartPanel
+		}{p.CommonPanel, *p.BarChartPanel}
+		return json.Marshal(outBarChart)
+	case HistogramType:
+		var outHistogram = struct {
+			CommonPanel
+			HistogramPanel
+		}{p.CommonPanel, *p.HistogramPanel}
+		return json.Marshal(outHistogram)
+	case XYChartType:
+		var outXYChart = struct {
+			CommonPanel
+			XYChartPanel
+		}{p.CommonPanel, *p.XYChartPanel}
+		return marshalWithSource(p.CommonPanel, outXYChart, p.source)
+	case GeomapType:
+		var outGeomap = struct {
+			CommonPanel
+			GeomapPanel
+		}{p.CommonPanel, *p.GeomapPanel}
+		return json.Marshal(outGeomap)
+	case NodeGraphType:
+		var outNodeGraph = struct {
+			CommonPanel
+			NodeGraphPanel
+		}{p.CommonPanel, *p.NodeGraphPanel}
+		return marshalWithSource(p.CommonPanel, outNodeGraph, p.source)
+	case TracesType:
+		var outTraces = struct {
+			CommonPanel
+			TracesPanel
+		}{p.CommonPanel, *p.TracesPanel}
+		return marshalWithSource(p.CommonPanel, outTraces, p.source)
+	case CandlestickType:
+		var outCandlestick = struct {
+			CommonPanel
+			CandlestickPanel
+		}{p.CommonPanel, *p.CandlestickPanel}
+		return json.Marshal(outCandlestick)
+	case CanvasType:
+		var outCanvas = struct {
+			CommonPanel
+			CanvasPanel
+		}{p.CommonPanel, *p.CanvasPanel}
+		return json.Marshal(outCanvas)
+	case FlameGraphType:
+		var outFlameGraph = struct {
+			CommonPanel
+			FlameGraphPanel
+		}{p.CommonPanel, *p.FlameGraphPanel}
+		return json.Marshal(outFlameGraph)
+	case NewsType:
+		var outNews = struct {
+			CommonPanel
+			NewsPanel
+		}{p.CommonPanel, *p.NewsPanel}
+		return json.Marshal(outNews)
+	case AnnotationsListType:
+		var outAnnotationsList = struct {
+			CommonPanel
+			AnnotationsListPanel
+		}{p.CommonPanel, *p.AnnotationsListPanel}
+		return json.Marshal(outAnnotationsList)
 	}
 	return nil, errors.New("can't marshal unknown panel type")
 }
@@ -876,3 +1694,122 @@ func (c customPanelOutput) MarshalJSON() ([]byte, error) {
 	buf.WriteString("}")
 	return buf.Bytes(), nil
 }
+
+func decodeSource(b []byte) (map[string]interface{}, error) {
+	var source map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	return source, decoder.Decode(&source)
+}
+
+// marshalWithSource marshals the typed panel on top of the JSON it was decoded from.
+// The keys of the source the typed panel does not model are kept as is, and the zero values
+// the typed panel adds are skipped. The CommonPanel keys are always written.
+func marshalWithSource(common CommonPanel, out interface{}, source map[string]interface{}) ([]byte, error) {
+	if source == nil {
+		return json.Marshal(out)
+	}
+
+	b, err := json.Marshal(common)
+	if err != nil {
+		return nil, err
+	}
+
+	commonKeys, err := decodeSource(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if b, err = json.Marshal(out); err != nil {
+		return nil, err
+	}
+
+	typed, err := decodeSource(b)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := mergeSource(source, typed).(map[string]interface{})
+
+	custom := make(CustomPanel)
+	for k, v := range merged {
+		if _, ok := commonKeys[k]; !ok {
+			custom[k] = v
+		}
+	}
+
+	return json.Marshal(customPanelOutput{common, custom})
+}
+
+func mergeSource(source interface{}, typed interface{}) interface{} {
+	if typed == nil {
+		return source
+	}
+
+	switch s := source.(type) {
+	case map[string]interface{}:
+		t, ok := typed.(map[string]interface{})
+		if !ok {
+			return typed
+		}
+
+		merged := make(map[string]interface{}, len(s))
+		for k, v := range s {
+			merged[k] = v
+		}
+
+		for k, v := range t {
+			if sv, ok := s[k]; ok {
+				merged[k] = mergeSource(sv, v)
+			} else if v, ok := pruneZero(v); ok {
+				merged[k] = v
+			}
+		}
+
+		return merged
+	case []interface{}:
+		t, ok := typed.([]interface{})
+		if !ok || len(t) != len(s) {
+			return typed
+		}
+
+		merged := make([]interface{}, len(s))
+		for i := range s {
+			merged[i] = mergeSource(s[i], t[i])
+		}
+
+		return merged
+	}
+
+	return typed
+}
+
+// pruneZero removes the zero values from the value. It returns false when nothing is left.
+func pruneZero(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case bool:
+		return v, v
+	case json.Number:
+		f, err := v.Float64()
+		return v, err != nil || f != 0
+	case []interface{}:
+		return v, len(v) > 0
+	case map[string]interface{}:
+		pruned := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			if item, ok := pruneZero(item); ok {
+				pruned[k] = item
+			}
+		}
+
+		return pruned, len(pruned) > 0
+	}
+
+	return value, true
+}
@@ -0,0 +1,608 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &HeatmapDataSource{}
+
+func NewHeatmapDataSource() datasource.DataSource {
+	return &HeatmapDataSource{}
+}
+
+// HeatmapDataSource defines the data source implementation.
+type HeatmapDataSource struct {
+	CompactJson bool
+	Defaults    HeatmapDefaults
+}
+
+type HeatmapDefaults struct {
+	Legend  HeatmapLegendDefaults
+	Tooltip HeatmapTooltipDefaults
+	Field   FieldDefaults
+	Graph   HeatmapGraphDefaults
+}
+
+type HeatmapLegendDefaults struct {
+	Show bool
+}
+
+type HeatmapTooltipDefaults struct {
+	Show          bool
+	ShowHistogram bool
+}
+
+type HeatmapGraphDefaults struct {
+	YBucketBound   string
+	CellGap        int
+	ShowValues     string
+	Color          HeatmapColorDefaults
+	ExemplarsColor string
+}
+
+type HeatmapColorDefaults struct {
+	Mode     string
+	Scheme   string
+	Fill     string
+	Scale    string
+	Exponent float64
+	Steps    int
+	Reverse  bool
+	Min      *float64
+	Max      *float64
+}
+
+// HeatmapDataSourceModel describes the data source data model.
+type HeatmapDataSourceModel struct {
+	Id              types.String                `tfsdk:"id"`
+	Json            types.String                `tfsdk:"json"`
+	CompactJson     types.Bool                  `tfsdk:"compact_json"`
+	Title           types.String                `tfsdk:"title"`
+	Description     types.String                `tfsdk:"description"`
+	Queries         []Query                     `tfsdk:"queries"`
+	Legend          []HeatmapLegendOptions      `tfsdk:"legend"`
+	Tooltip         []HeatmapTooltipOptions     `tfsdk:"tooltip"`
+	Field           []FieldOptions              `tfsdk:"field"`
+	Graph           []HeatmapGraphOptions       `tfsdk:"graph"`
+	Calculation     []HeatmapCalculationOptions `tfsdk:"calculation"`
+	Overrides       []FieldOverrideOptions      `tfsdk:"overrides"`
+	Transformations []Transformations           `tfsdk:"transform"`
+}
+
+type HeatmapLegendOptions struct {
+	Show types.Bool `tfsdk:"show"`
+}
+
+type HeatmapTooltipOptions struct {
+	Show          types.Bool `tfsdk:"show"`
+	ShowHistogram types.Bool `tfsdk:"show_histogram"`
+}
+
+type HeatmapGraphOptions struct {
+	YBucketBound types.String              `tfsdk:"y_bucket_bound"`
+	CellGap      types.Int64               `tfsdk:"cell_gap"`
+	ShowValues   types.String              `tfsdk:"show_values"`
+	Color        []HeatmapColorOptions     `tfsdk:"color"`
+	Exemplars    []HeatmapExemplarsOptions `tfsdk:"exemplars"`
+}
+
+type HeatmapColorOptions struct {
+	Mode     types.String  `tfsdk:"mode"`
+	Scheme   types.String  `tfsdk:"scheme"`
+	Fill     types.String  `tfsdk:"fill"`
+	Scale    types.String  `tfsdk:"scale"`
+	Exponent types.Float64 `tfsdk:"exponent"`
+	Steps    types.Int64   `tfsdk:"steps"`
+	Reverse  types.Bool    `tfsdk:"reverse"`
+	Min      types.Float64 `tfsdk:"min"`
+	Max      types.Float64 `tfsdk:"max"`
+}
+
+type HeatmapExemplarsOptions struct {
+	Color types.String `tfsdk:"color"`
+}
+
+type HeatmapCalculationOptions struct {
+	XBuckets []HeatmapBucketsOptions `tfsdk:"x_buckets"`
+	YBuckets []HeatmapBucketsOptions `tfsdk:"y_buckets"`
+}
+
+type HeatmapBucketsOptions struct {
+	Mode  types.String   `tfsdk:"mode"`
+	Value types.String   `tfsdk:"value"`
+	Scale []ScaleOptions `tfsdk:"scale"`
+}
+
+func (d *HeatmapDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heatmap"
+}
+
+func heatmapColorSchemes() []string {
+	return []string{
+		"Blues", "Greens", "Greys", "Oranges", "Purples", "Reds",
+		"Turbo", "Cividis", "Viridis", "Magma", "Inferno", "Plasma", "Warm", "Cool", "Cubehelix",
+		"BuGn", "BuPu", "GnBu", "OrRd", "PuBuGn", "PuBu", "PuRd", "RdPu", "YlGnBu", "YlGn", "YlOrBr", "YlOrRd",
+		"BrBG", "PRGn", "PiYG", "PuOr", "RdBu", "RdGy", "RdYlBu", "RdYlGn", "Spectral",
+		"Rainbow", "Sinebow",
+	}
+}
+
+func heatmapLegendBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Legend options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"show": schema.BoolAttribute{
+					Required:    true,
+					Description: "Whether to show the color scale legend or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func heatmapTooltipBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The tooltip visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"show": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the tooltip or not.",
+				},
+				"show_histogram": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show a y-axis histogram in the tooltip or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func heatmapGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"color": schema.ListNestedBlock{
+					Description: "The color options of the heatmap cells.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color mode. The choices are: scheme, opacity.",
+								MarkdownDescription: "The color mode. The choices are: `scheme`, `opacity`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("scheme", "opacity"),
+								},
+							},
+							"scheme": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color scheme to use in the scheme mode. For example: Oranges, Spectral, Viridis, RdYlGn.",
+								MarkdownDescription: "The color scheme to use in the `scheme` mode. For example: `Oranges`, `Spectral`, `Viridis`, `RdYlGn`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(heatmapColorSchemes()...),
+								},
+							},
+							"fill": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color to use in the opacity mode.",
+								MarkdownDescription: "The color to use in the `opacity` mode.",
+							},
+							"scale": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The scale of the color in the opacity mode. The choices are: exponential, linear.",
+								MarkdownDescription: "The scale of the color in the `opacity` mode. The choices are: `exponential`, `linear`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("exponential", "linear"),
+								},
+							},
+							"exponent": schema.Float64Attribute{
+								Optional:            true,
+								Description:         "The exponent of the exponential scale. Must be between 0.01 and 2 (inclusive).",
+								MarkdownDescription: "The exponent of the `exponential` scale. Must be between `0.01` and `2` (inclusive).",
+								Validators: []validator.Float64{
+									float64validator.Between(0.01, 2),
+								},
+							},
+							"steps": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "The number of color steps. Must be between 2 and 128 (inclusive).",
+								MarkdownDescription: "The number of color steps. Must be between `2` and `128` (inclusive).",
+								Validators: []validator.Int64{
+									int64validator.Between(2, 128),
+								},
+							},
+							"reverse": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to reverse the color scheme or not.",
+							},
+							"min": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The lower bound of the color scale. By default, it is calculated from the data.",
+							},
+							"max": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The upper bound of the color scale. By default, it is calculated from the data.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"exemplars": schema.ListNestedBlock{
+					Description: "The exemplars options.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"color": schema.StringAttribute{
+								Required:    true,
+								Description: "The color of the exemplars.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"y_bucket_bound": schema.StringAttribute{
+					Optional: true,
+					Description: "The bound of the y-axis buckets of the pre-bucketed data. " +
+						"The choices are: auto, upper, middle, lower.",
+					MarkdownDescription: "The bound of the y-axis buckets of the pre-bucketed data. " +
+						"The choices are: `auto`, `upper`, `middle`, `lower`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "upper", "middle", "lower"),
+					},
+				},
+				"cell_gap": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The gap between the cells. Must be between 0 and 25 (inclusive).",
+					MarkdownDescription: "The gap between the cells. Must be between `0` and `25` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 25),
+					},
+				},
+				"show_values": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Whether to show the values in the cells. The choices are: auto, always, never.",
+					MarkdownDescription: "Whether to show the values in the cells. The choices are: `auto`, `always`, `never`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "always", "never"),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func heatmapBucketsBlock(axis string) schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The buckets of the " + axis + "-axis.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"scale": scaleBlock(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "How to define the buckets. The choices are: size, count.",
+					MarkdownDescription: "How to define the buckets. The choices are: `size`, `count`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("size", "count"),
+					},
+				},
+				"value": schema.StringAttribute{
+					Optional:    true,
+					Description: "The size or the count of the buckets depending on the mode. For example, 1m or 10.",
+					MarkdownDescription: "The size or the count of the buckets depending on the `mode`. " +
+						"For example, `1m` or `10`.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func heatmapCalculationBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Calculate the heatmap from the raw data. Omit the block when the data is already bucketed.",
+		MarkdownDescription: "Calculate the heatmap from the raw data. " +
+			"Omit the block when the data is already bucketed, for example, when Prometheus query uses the `heatmap` format.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"x_buckets": heatmapBucketsBlock("x"),
+				"y_buckets": heatmapBucketsBlock("y"),
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *HeatmapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Heatmap panel data source.",
+		MarkdownDescription: "Heatmap panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/heatmap/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":     queryBlock(),
+			"legend":      heatmapLegendBlock(),
+			"tooltip":     heatmapTooltipBlock(),
+			"field":       fieldBlock(false),
+			"graph":       heatmapGraphBlock(),
+			"calculation": heatmapCalculationBlock(),
+			"overrides":   fieldOverrideBlock(false),
+			"transform":   transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *HeatmapDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.Heatmap
+}
+
+func (d *HeatmapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HeatmapDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+	transformations := createTransformations(data.Transformations)
+
+	fieldConfig.Custom.ScaleDistribution.Type = "linear"
+
+	graphDefaults := d.Defaults.Graph
+
+	for _, graph := range data.Graph {
+		updateHeatmapGraphDefaults(&graphDefaults, graph)
+	}
+
+	options := grafana.HeatmapOptions{
+		Calculate: false,
+		CellGap:   graphDefaults.CellGap,
+		Color: grafana.HeatmapColorOptions{
+			Mode:     graphDefaults.Color.Mode,
+			Scheme:   graphDefaults.Color.Scheme,
+			Fill:     graphDefaults.Color.Fill,
+			Scale:    graphDefaults.Color.Scale,
+			Exponent: graphDefaults.Color.Exponent,
+			Steps:    graphDefaults.Color.Steps,
+			Reverse:  graphDefaults.Color.Reverse,
+			Min:      graphDefaults.Color.Min,
+			Max:      graphDefaults.Color.Max,
+		},
+		Exemplars: grafana.HeatmapExemplarsOptions{
+			Color: graphDefaults.ExemplarsColor,
+		},
+		Legend: grafana.HeatmapLegendOptions{
+			Show: d.Defaults.Legend.Show,
+		},
+		RowsFrame: grafana.HeatmapRowsFrameOptions{
+			Layout: heatmapRowsFrameLayout(graphDefaults.YBucketBound),
+		},
+		ShowValue: graphDefaults.ShowValues,
+		Tooltip: grafana.HeatmapTooltipOptions{
+			Show:       d.Defaults.Tooltip.Show,
+			YHistogram: d.Defaults.Tooltip.ShowHistogram,
+		},
+		YAxis: grafana.HeatmapYAxisOptions{
+			AxisPlacement: "left",
+			Reverse:       false,
+		},
+	}
+
+	for _, legend := range data.Legend {
+		options.Legend.Show = legend.Show.ValueBool()
+	}
+
+	for _, tooltip := range data.Tooltip {
+		if !tooltip.Show.IsNull() {
+			options.Tooltip.Show = tooltip.Show.ValueBool()
+		}
+
+		if !tooltip.ShowHistogram.IsNull() {
+			options.Tooltip.YHistogram = tooltip.ShowHistogram.ValueBool()
+		}
+	}
+
+	for _, calculation := range data.Calculation {
+		options.Calculate = true
+		options.Calculation = &grafana.HeatmapCalculation{}
+
+		for _, buckets := range calculation.XBuckets {
+			options.Calculation.XBuckets = createHeatmapCalculationBuckets(buckets)
+		}
+
+		for _, buckets := range calculation.YBuckets {
+			options.Calculation.YBuckets = createHeatmapCalculationBuckets(buckets)
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.HeatmapType,
+			Title:           data.Title.ValueString(),
+			Type:            "heatmap",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		HeatmapPanel: &grafana.HeatmapPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func updateHeatmapGraphDefaults(defaults *HeatmapGraphDefaults, graph HeatmapGraphOptions) {
+	if !graph.YBucketBound.IsNull() {
+		defaults.YBucketBound = graph.YBucketBound.ValueString()
+	}
+
+	if !graph.CellGap.IsNull() {
+		defaults.CellGap = int(graph.CellGap.ValueInt64())
+	}
+
+	if !graph.ShowValues.IsNull() {
+		defaults.ShowValues = graph.ShowValues.ValueString()
+	}
+
+	for _, color := range graph.Color {
+		if !color.Mode.IsNull() {
+			defaults.Color.Mode = color.Mode.ValueString()
+		}
+
+		if !color.Scheme.IsNull() {
+			defaults.Color.Scheme = color.Scheme.ValueString()
+		}
+
+		if !color.Fill.IsNull() {
+			defaults.Color.Fill = color.Fill.ValueString()
+		}
+
+		if !color.Scale.IsNull() {
+			defaults.Color.Scale = color.Scale.ValueString()
+		}
+
+		if !color.Exponent.IsNull() {
+			defaults.Color.Exponent = color.Exponent.ValueFloat64()
+		}
+
+		if !color.Steps.IsNull() {
+			defaults.Color.Steps = int(color.Steps.ValueInt64())
+		}
+
+		if !color.Reverse.IsNull() {
+			defaults.Color.Reverse = color.Reverse.ValueBool()
+		}
+
+		if !color.Min.IsNull() {
+			defaults.Color.Min = color.Min.ValueFloat64Pointer()
+		}
+
+		if !color.Max.IsNull() {
+			defaults.Color.Max = color.Max.ValueFloat64Pointer()
+		}
+	}
+
+	for _, exemplars := range graph.Exemplars {
+		defaults.ExemplarsColor = exemplars.Color.ValueString()
+	}
+}
+
+func createHeatmapCalculationBuckets(buckets HeatmapBucketsOptions) *grafana.HeatmapCalculationBuckets {
+	result := &grafana.HeatmapCalculationBuckets{
+		Mode:  buckets.Mode.ValueString(),
+		Value: buckets.Value.ValueString(),
+	}
+
+	for _, scale := range buckets.Scale {
+		result.Scale = &grafana.HeatmapCalculationScale{
+			Type: scale.Type.ValueString(),
+			Log:  int(scale.Log.ValueInt64()),
+		}
+	}
+
+	return result
+}
+
+func heatmapRowsFrameLayout(bound string) string {
+	switch bound {
+	case "upper":
+		return "le"
+	case "lower":
+		return "ge"
+	case "middle":
+		return "unknown"
+	default:
+		return "auto"
+	}
+}
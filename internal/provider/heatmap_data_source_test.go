@@ -0,0 +1,457 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHeatmapDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccHeatmapDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "json", testAccHeatmapDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccHeatmapDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "json", testAccHeatmapDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccHeatmapDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_heatmap.test", "json", testAccHeatmapDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccHeatmapDataSourceConfig = `
+data "gdashboard_heatmap" "test" {
+  title       = "Test"
+  description = "Heatmap description"
+
+  legend {
+    show = false
+  }
+
+  tooltip {
+    show           = true
+    show_histogram = true
+  }
+
+  field {
+    unit     = "s"
+    decimals = 2
+  }
+
+  graph {
+    cell_gap    = 2
+    show_values = "never"
+
+    color {
+      mode    = "scheme"
+      scheme  = "Spectral"
+      steps   = 32
+      reverse = true
+      min     = 0
+      max     = 100
+    }
+
+    exemplars {
+      color = "red"
+    }
+  }
+
+  calculation {
+    x_buckets {
+      mode  = "size"
+      value = "1m"
+    }
+
+    y_buckets {
+      mode  = "count"
+      value = "10"
+
+      scale {
+        type = "log"
+        log  = 2
+      }
+    }
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(http_request_duration_seconds{container_name='container'}[$__rate_interval]))"
+      ref_id = "Prometheus_Query"
+    }
+  }
+}
+`
+
+const testAccHeatmapDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Heatmap description",
+  "transparent": false,
+  "type": "heatmap",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum(rate(http_request_duration_seconds{container_name='container'}[$__rate_interval]))"
+    }
+  ],
+  "options": {
+    "calculate": true,
+    "calculation": {
+      "xBuckets": {
+        "mode": "size",
+        "value": "1m"
+      },
+      "yBuckets": {
+        "mode": "count",
+        "value": "10",
+        "scale": {
+          "type": "log",
+          "log": 2
+        }
+      }
+    },
+    "cellGap": 2,
+    "color": {
+      "mode": "scheme",
+      "scheme": "Spectral",
+      "fill": "dark-orange",
+      "scale": "exponential",
+      "exponent": 0.5,
+      "steps": 32,
+      "reverse": true,
+      "min": 0,
+      "max": 100
+    },
+    "exemplars": {
+      "color": "red"
+    },
+    "legend": {
+      "show": false
+    },
+    "rowsFrame": {
+      "layout": "auto"
+    },
+    "showValue": "never",
+    "tooltip": {
+      "show": true,
+      "yHistogram": true
+    },
+    "yAxis": {
+      "axisPlacement": "left",
+      "reverse": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "s",
+      "decimals": 2,
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccHeatmapDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    heatmap {
+      legend {
+        show = false
+      }
+
+      tooltip {
+        show_histogram = true
+      }
+
+      field {
+        unit = "s"
+      }
+
+      graph {
+        y_bucket_bound = "upper"
+        cell_gap       = 0
+
+        color {
+          mode     = "opacity"
+          fill     = "blue"
+          scale    = "linear"
+          exponent = 0.7
+        }
+      }
+    }
+  }
+}
+
+data "gdashboard_heatmap" "test" {
+  title = "Test"
+}
+`
+
+const testAccHeatmapDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "heatmap",
+  "options": {
+    "calculate": false,
+    "cellGap": 0,
+    "color": {
+      "mode": "opacity",
+      "scheme": "Oranges",
+      "fill": "blue",
+      "scale": "linear",
+      "exponent": 0.7,
+      "steps": 64,
+      "reverse": false
+    },
+    "exemplars": {
+      "color": "rgba(255,0,255,0.7)"
+    },
+    "legend": {
+      "show": false
+    },
+    "rowsFrame": {
+      "layout": "le"
+    },
+    "showValue": "auto",
+    "tooltip": {
+      "show": true,
+      "yHistogram": true
+    },
+    "yAxis": {
+      "axisPlacement": "left",
+      "reverse": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "s",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccHeatmapDataSourceProviderDefaultsConfig = `
+data "gdashboard_heatmap" "test" {
+  title = "Test"
+}
+`
+
+const testAccHeatmapDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "heatmap",
+  "options": {
+    "calculate": false,
+    "cellGap": 1,
+    "color": {
+      "mode": "scheme",
+      "scheme": "Oranges",
+      "fill": "dark-orange",
+      "scale": "exponential",
+      "exponent": 0.5,
+      "steps": 64,
+      "reverse": false
+    },
+    "exemplars": {
+      "color": "rgba(255,0,255,0.7)"
+    },
+    "legend": {
+      "show": true
+    },
+    "rowsFrame": {
+      "layout": "auto"
+    },
+    "showValue": "auto",
+    "tooltip": {
+      "show": true,
+      "yHistogram": false
+    },
+    "yAxis": {
+      "axisPlacement": "left",
+      "reverse": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &HistogramDataSource{}
+
+func NewHistogramDataSource() datasource.DataSource {
+	return &HistogramDataSource{}
+}
+
+// HistogramDataSource defines the data source implementation.
+type HistogramDataSource struct {
+	CompactJson bool
+	Defaults    HistogramDefaults
+}
+
+type HistogramDefaults struct {
+	Legend  TimeseriesLegendDefault
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Graph   HistogramGraphDefaults
+}
+
+type HistogramGraphDefaults struct {
+	BucketSize    *float64
+	BucketOffset  *float64
+	BucketCount   *int64
+	CombineSeries bool
+	FillOpacity   int
+	GradientMode  string
+	StackSeries   string
+}
+
+// HistogramDataSourceModel describes the data source data model.
+type HistogramDataSourceModel struct {
+	Id              types.String               `tfsdk:"id"`
+	Json            types.String               `tfsdk:"json"`
+	CompactJson     types.Bool                 `tfsdk:"compact_json"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Queries         []Query                    `tfsdk:"queries"`
+	Legend          []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field           []FieldOptions             `tfsdk:"field"`
+	Graph           []HistogramGraphOptions    `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions     `tfsdk:"overrides"`
+	Transformations []Transformations          `tfsdk:"transform"`
+}
+
+type HistogramGraphOptions struct {
+	BucketSize    types.Float64 `tfsdk:"bucket_size"`
+	BucketOffset  types.Float64 `tfsdk:"bucket_offset"`
+	BucketCount   types.Int64   `tfsdk:"bucket_count"`
+	CombineSeries types.Bool    `tfsdk:"combine_series"`
+	FillOpacity   types.Int64   `tfsdk:"fill_opacity"`
+	GradientMode  types.String  `tfsdk:"gradient_mode"`
+	StackSeries   types.String  `tfsdk:"stack_series"`
+}
+
+func (d *HistogramDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_histogram"
+}
+
+func histogramGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"bucket_size": schema.Float64Attribute{
+					Optional:    true,
+					Description: "The size of the buckets. By default, the bucket size is calculated automatically.",
+					Validators: []validator.Float64{
+						float64validator.AtLeast(0),
+					},
+				},
+				"bucket_offset": schema.Float64Attribute{
+					Optional:    true,
+					Description: "The offset of the first bucket. Useful when the buckets should not start at zero.",
+				},
+				"bucket_count": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The desired number of buckets when the bucket size is calculated automatically.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"combine_series": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to merge all series and fields into a combined histogram or not.",
+				},
+				"fill_opacity": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The opacity of the bars. Must be between 0 and 100 (inclusive).",
+					MarkdownDescription: "The opacity of the bars. Must be between `0` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 100),
+					},
+				},
+				"gradient_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The gradient mode. The choices are: none, opacity, hue, scheme.",
+					MarkdownDescription: "The gradient mode. The choices are: `none`, `opacity`, `hue`, `scheme`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "opacity", "hue", "scheme"),
+					},
+				},
+				"stack_series": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to stack the series. The choices are: none, normal, percent.",
+					MarkdownDescription: "Choose how to stack the series. The choices are: `none`, `normal`, `percent`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "normal", "percent"),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *HistogramDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Histogram panel data source.",
+		MarkdownDescription: "Histogram panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/histogram/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    timeseriesLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"graph":     histogramGraphBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *HistogramDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.Histogram
+}
+
+func (d *HistogramDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HistogramDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       d.Defaults.Legend.Calculations,
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if len(legend.Calculations) > 0 {
+			calculations := make([]string, len(legend.Calculations))
+			for i, calc := range legend.Calculations {
+				calculations[i] = calc.ValueString()
+			}
+
+			legendOptions.Calcs = calculations
+		}
+
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	options := grafana.HistogramOptions{
+		BucketSize:   d.Defaults.Graph.BucketSize,
+		BucketOffset: d.Defaults.Graph.BucketOffset,
+		BucketCount:  d.Defaults.Graph.BucketCount,
+		Combine:      d.Defaults.Graph.CombineSeries,
+		Legend:       legendOptions,
+		Tooltip:      tooltipOptions,
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		LineWidth:    1,
+		FillOpacity:  d.Defaults.Graph.FillOpacity,
+		GradientMode: d.Defaults.Graph.GradientMode,
+	}
+
+	fieldConfig.Custom.Stacking.Mode = d.Defaults.Graph.StackSeries
+	fieldConfig.Custom.Stacking.Group = "A"
+
+	for _, graph := range data.Graph {
+		if !graph.BucketSize.IsNull() {
+			options.BucketSize = graph.BucketSize.ValueFloat64Pointer()
+		}
+
+		if !graph.BucketOffset.IsNull() {
+			options.BucketOffset = graph.BucketOffset.ValueFloat64Pointer()
+		}
+
+		if !graph.BucketCount.IsNull() {
+			options.BucketCount = graph.BucketCount.ValueInt64Pointer()
+		}
+
+		if !graph.CombineSeries.IsNull() {
+			options.Combine = graph.CombineSeries.ValueBool()
+		}
+
+		if !graph.FillOpacity.IsNull() {
+			fieldConfig.Custom.FillOpacity = int(graph.FillOpacity.ValueInt64())
+		}
+
+		if !graph.GradientMode.IsNull() {
+			fieldConfig.Custom.GradientMode = graph.GradientMode.ValueString()
+		}
+
+		if !graph.StackSeries.IsNull() {
+			fieldConfig.Custom.Stacking.Mode = graph.StackSeries.ValueString()
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.HistogramType,
+			Title:           data.Title.ValueString(),
+			Type:            "histogram",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		HistogramPanel: &grafana.HistogramPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
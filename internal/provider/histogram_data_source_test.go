@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHistogramDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccHistogramDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "json", testAccHistogramDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccHistogramDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "json", testAccHistogramDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccHistogramDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_histogram.test", "json", testAccHistogramDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccHistogramDataSourceTestDataMissingScenarioConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+		},
+	})
+}
+
+const testAccHistogramDataSourceTestDataMissingScenarioConfig = `
+data "gdashboard_histogram" "test" {
+  title = "Test"
+
+  queries {
+    testdata {
+      uid = "testdata"
+    }
+  }
+}
+`
+
+const testAccHistogramDataSourceConfig = `
+data "gdashboard_histogram" "test" {
+  title       = "Test"
+  description = "Histogram description"
+
+  legend {
+    calculations = ["count"]
+    display_mode = "table"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit = "bytes"
+  }
+
+  graph {
+    bucket_size    = 1024
+    bucket_offset  = 512
+    bucket_count   = 20
+    combine_series = true
+    fill_opacity   = 60
+    gradient_mode  = "opacity"
+    stack_series   = "normal"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "http_response_size_bytes{container_name='container'}"
+      ref_id = "Prometheus_Query"
+    }
+
+    testdata {
+      uid    = "testdata"
+      ref_id = "Demo"
+
+      random_walk {
+        series_count = 3
+        seed         = 42
+        start_value  = 1024
+        min          = 0
+        spread       = 64
+        noise        = 2.5
+        drop         = 10
+      }
+    }
+
+    testdata {
+      uid = "testdata"
+
+      csv_content {
+        content = "size,count\n128,10\n256,25\n512,5"
+      }
+    }
+  }
+}
+`
+
+const testAccHistogramDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Histogram description",
+  "transparent": false,
+  "type": "histogram",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "http_response_size_bytes{container_name='container'}"
+    },
+    {
+      "refId": "Demo",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "scenarioId": "random_walk",
+      "seriesCount": 3,
+      "seed": 42,
+      "min": 0,
+      "startValue": 1024,
+      "spread": 64,
+      "noise": 2.5,
+      "drop": 10
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "scenarioId": "csv_content",
+      "csvContent": "size,count\n128,10\n256,25\n512,5"
+    }
+  ],
+  "options": {
+    "bucketSize": 1024,
+    "bucketOffset": 512,
+    "bucketCount": 20,
+    "combine": true,
+    "legend": {
+      "calcs": [
+        "count"
+      ],
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "bytes",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 60,
+        "gradientMode": "opacity",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "A",
+          "mode": "normal"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccHistogramDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    histogram {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "s"
+      }
+
+      graph {
+        bucket_count   = 10
+        combine_series = true
+        fill_opacity   = 100
+        gradient_mode  = "hue"
+        stack_series   = "percent"
+      }
+    }
+  }
+}
+
+data "gdashboard_histogram" "test" {
+  title = "Test"
+}
+`
+
+const testAccHistogramDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "histogram",
+  "options": {
+    "bucketCount": 10,
+    "combine": true,
+    "legend": {
+      "calcs": [],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "s",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 100,
+        "gradientMode": "hue",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "A",
+          "mode": "percent"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccHistogramDataSourceProviderDefaultsConfig = `
+data "gdashboard_histogram" "test" {
+  title = "Test"
+}
+`
+
+const testAccHistogramDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "histogram",
+  "options": {
+    "combine": false,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 80,
+        "gradientMode": "none",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "A",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -24,6 +24,27 @@ func TestAccLogsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "json", testAccLogsDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccLogsDataSourceTestDataConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "json", testAccLogsDataSourceTestDataConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccLogsDataSourceElasticsearchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "json", testAccLogsDataSourceElasticsearchConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccLogsDataSourceLokiConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_logs.test", "json", testAccLogsDataSourceLokiConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccLogsDataSourceEmptyConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -129,6 +150,231 @@ const testAccLogsDataSourceConfigExpectedJson = `{
   ]
 }`
 
+const testAccLogsDataSourceTestDataConfig = `
+data "gdashboard_logs" "test" {
+  title = "Test"
+
+  queries {
+    testdata {
+      uid  = "testdata"
+      hide = true
+
+      logs {
+        lines        = 50
+        level_column = true
+      }
+    }
+  }
+}
+`
+
+const testAccLogsDataSourceTestDataConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "logs",
+  "options": {
+    "showTime": false,
+    "showLabels": false,
+    "showCommonLabels": false,
+    "wrapLogMessage": false,
+    "prettifyLogMessage": false,
+    "enableLogDetails": true,
+    "dedupStrategy": "none",
+    "sortOrder": "Descending"
+  },
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "scenarioId": "logs",
+      "lines": 50,
+      "levelColumn": true
+    }
+  ]
+}`
+
+const testAccLogsDataSourceElasticsearchConfig = `
+data "gdashboard_logs" "test" {
+  title = "Test"
+
+  queries {
+    elasticsearch {
+      uid        = "elasticsearch"
+      query      = "level:error"
+      time_field = "@timestamp"
+
+      metric {
+        logs {
+          limit = 100
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccLogsDataSourceElasticsearchConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "logs",
+  "options": {
+    "showTime": false,
+    "showLabels": false,
+    "showCommonLabels": false,
+    "wrapLogMessage": false,
+    "prettifyLogMessage": false,
+    "enableLogDetails": true,
+    "dedupStrategy": "none",
+    "sortOrder": "Descending"
+  },
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "elasticsearch",
+        "name": "",
+        "type": "elasticsearch",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "query": "level:error",
+      "timeField": "@timestamp",
+      "metrics": [
+        {
+          "id": "1",
+          "type": "logs",
+          "settings": {
+            "limit": "100"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+const testAccLogsDataSourceLokiConfig = `
+data "gdashboard_logs" "test" {
+  title = "Test"
+
+  queries {
+    loki {
+      uid       = "loki"
+      expr      = "{app=\"backend\"} |= \"error\""
+      max_lines = 500
+      direction = "forward"
+      ref_id    = "Logs"
+    }
+
+    loki {
+      uid           = "loki"
+      expr          = "sum by (level) (count_over_time({app=\"backend\"}[$__auto]))"
+      query_type    = "instant"
+      legend_format = "{{level}}"
+      resolution    = 2
+      step          = "1m"
+      hide          = true
+    }
+  }
+}
+`
+
+const testAccLogsDataSourceLokiConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "logs",
+  "options": {
+    "showTime": false,
+    "showLabels": false,
+    "showCommonLabels": false,
+    "wrapLogMessage": false,
+    "prettifyLogMessage": false,
+    "enableLogDetails": true,
+    "dedupStrategy": "none",
+    "sortOrder": "Descending"
+  },
+  "targets": [
+    {
+      "refId": "Logs",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "loki",
+        "name": "",
+        "type": "loki",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "range",
+      "expr": "{app=\"backend\"} |= \"error\"",
+      "maxLines": 500,
+      "direction": "forward"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "loki",
+        "name": "",
+        "type": "loki",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "queryType": "instant",
+      "expr": "sum by (level) (count_over_time({app=\"backend\"}[$__auto]))",
+      "step": "1m",
+      "legendFormat": "{{level}}",
+      "resolution": 2
+    }
+  ]
+}`
+
 const testAccLogsDataSourceEmptyConfig = `
 data "gdashboard_logs" "test" {
   title = "Test"
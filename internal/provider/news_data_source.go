@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NewsDataSource{}
+
+func NewNewsDataSource() datasource.DataSource {
+	return &NewsDataSource{}
+}
+
+// NewsDataSource defines the data source implementation.
+type NewsDataSource struct {
+	CompactJson bool
+}
+
+// NewsDataSourceModel describes the data source data model.
+type NewsDataSourceModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Json        types.String  `tfsdk:"json"`
+	CompactJson types.Bool    `tfsdk:"compact_json"`
+	Title       types.String  `tfsdk:"title"`
+	Description types.String  `tfsdk:"description"`
+	Graph       []NewsOptions `tfsdk:"graph"`
+}
+
+type NewsOptions struct {
+	FeedURL   types.String `tfsdk:"feed_url"`
+	ShowImage types.Bool   `tfsdk:"show_image"`
+	UseProxy  types.Bool   `tfsdk:"use_proxy"`
+}
+
+func (d *NewsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_news"
+}
+
+func newsGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"feed_url": schema.StringAttribute{
+					Required:    true,
+					Description: "The URL of the RSS or Atom feed.",
+				},
+				"show_image": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the social image of the news items or not. Defaults to true.",
+				},
+				"use_proxy": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to load the feed through a CORS proxy or not. Defaults to false.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *NewsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "News panel data source.",
+		MarkdownDescription: "News panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/news/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"graph": newsGraphBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *NewsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *NewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NewsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := grafana.NewsPanelOptions{
+		FeedURL:   "",
+		ShowImage: true,
+		UseProxy:  false,
+	}
+
+	for _, graph := range data.Graph {
+		options.FeedURL = graph.FeedURL.ValueString()
+
+		if !graph.ShowImage.IsNull() {
+			options.ShowImage = graph.ShowImage.ValueBool()
+		}
+
+		if !graph.UseProxy.IsNull() {
+			options.UseProxy = graph.UseProxy.ValueBool()
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType: grafana.NewsType,
+			Title:  data.Title.ValueString(),
+			Type:   "news",
+			Span:   12,
+			IsNew:  true,
+		},
+		NewsPanel: &grafana.NewsPanel{
+			Options: options,
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNewsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_news.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_news.test", "json", testAccNewsDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccNewsDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_news.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_news.test", "json", testAccNewsDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccNewsDataSourceConfig = `
+data "gdashboard_news" "test" {
+  title       = "Test"
+  description = "News description"
+
+  graph {
+    feed_url   = "https://grafana.com/blog/news.xml"
+    show_image = false
+    use_proxy  = true
+  }
+}
+`
+
+const testAccNewsDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "News description",
+  "transparent": false,
+  "type": "news",
+  "options": {
+    "feedUrl": "https://grafana.com/blog/news.xml",
+    "showImage": false,
+    "useProxy": true
+  }
+}`
+
+const testAccNewsDataSourceProviderDefaultsConfig = `
+data "gdashboard_news" "test" {
+  title = "Test"
+}
+`
+
+const testAccNewsDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "news",
+  "options": {
+    "feedUrl": "",
+    "showImage": true,
+    "useProxy": false
+  }
+}`
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NodeGraphDataSource{}
+
+func NewNodeGraphDataSource() datasource.DataSource {
+	return &NodeGraphDataSource{}
+}
+
+// NodeGraphDataSource defines the data source implementation.
+type NodeGraphDataSource struct {
+	CompactJson bool
+}
+
+// NodeGraphDataSourceModel describes the data source data model.
+type NodeGraphDataSourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Json        types.String           `tfsdk:"json"`
+	CompactJson types.Bool             `tfsdk:"compact_json"`
+	Title       types.String           `tfsdk:"title"`
+	Description types.String           `tfsdk:"description"`
+	Queries     []Query                `tfsdk:"queries"`
+	Nodes       []NodeGraphNodeOptions `tfsdk:"nodes"`
+	Edges       []NodeGraphEdgeOptions `tfsdk:"edges"`
+}
+
+type NodeGraphNodeOptions struct {
+	MainStatUnit      types.String          `tfsdk:"main_stat_unit"`
+	SecondaryStatUnit types.String          `tfsdk:"secondary_stat_unit"`
+	Arcs              []NodeGraphArcOptions `tfsdk:"arc"`
+}
+
+type NodeGraphEdgeOptions struct {
+	MainStatUnit      types.String `tfsdk:"main_stat_unit"`
+	SecondaryStatUnit types.String `tfsdk:"secondary_stat_unit"`
+}
+
+type NodeGraphArcOptions struct {
+	Field types.String `tfsdk:"field"`
+	Color types.String `tfsdk:"color"`
+}
+
+func (d *NodeGraphDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_graph"
+}
+
+func nodeGraphNodesBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The options of the nodes.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"arc": schema.ListNestedBlock{
+					Description:         "The colored sections of the circle around the node. The values of the fields must be between 0 and 1 and add up to 1.",
+					MarkdownDescription: "The colored sections of the circle around the node. The values of the fields must be between `0` and `1` and add up to `1`.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"field": schema.StringAttribute{
+								Required:            true,
+								Description:         "The name of the field with the value of the section. For example: arc__success.",
+								MarkdownDescription: "The name of the field with the value of the section. For example: `arc__success`.",
+							},
+							"color": schema.StringAttribute{
+								Required:    true,
+								Description: "The color of the section.",
+							},
+						},
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"main_stat_unit": schema.StringAttribute{
+					Optional:    true,
+					Description: "The unit of the main stat of the nodes.",
+				},
+				"secondary_stat_unit": schema.StringAttribute{
+					Optional:    true,
+					Description: "The unit of the secondary stat of the nodes.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func nodeGraphEdgesBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The options of the edges.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"main_stat_unit": schema.StringAttribute{
+					Optional:    true,
+					Description: "The unit of the main stat of the edges.",
+				},
+				"secondary_stat_unit": schema.StringAttribute{
+					Optional:    true,
+					Description: "The unit of the secondary stat of the edges.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *NodeGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Node graph panel data source.",
+		MarkdownDescription: "Node graph panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/node-graph/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries": queryBlock(),
+			"nodes":   nodeGraphNodesBlock(),
+			"edges":   nodeGraphEdgesBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *NodeGraphDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *NodeGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeGraphDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+
+	options := grafana.NodeGraphOptions{
+		Nodes: grafana.NodeGraphNodeOptions{
+			Arcs: []grafana.NodeGraphArcOption{},
+		},
+	}
+
+	for _, nodes := range data.Nodes {
+		options.Nodes.MainStatUnit = nodes.MainStatUnit.ValueString()
+		options.Nodes.SecondaryStatUnit = nodes.SecondaryStatUnit.ValueString()
+
+		for _, arc := range nodes.Arcs {
+			options.Nodes.Arcs = append(options.Nodes.Arcs, grafana.NodeGraphArcOption{
+				Field: arc.Field.ValueString(),
+				Color: arc.Color.ValueString(),
+			})
+		}
+	}
+
+	for _, edges := range data.Edges {
+		options.Edges.MainStatUnit = edges.MainStatUnit.ValueString()
+		options.Edges.SecondaryStatUnit = edges.SecondaryStatUnit.ValueString()
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:   grafana.NodeGraphType,
+			Title:    data.Title.ValueString(),
+			Type:     "nodeGraph",
+			Span:     12,
+			IsNew:    true,
+			Interval: minInterval,
+		},
+		NodeGraphPanel: &grafana.NodeGraphPanel{
+			Targets: targets,
+			Options: options,
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNodeGraphDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNodeGraphDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_node_graph.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_node_graph.test", "json", testAccNodeGraphDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccNodeGraphDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_node_graph.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_node_graph.test", "json", testAccNodeGraphDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccNodeGraphDataSourceConfig = `
+data "gdashboard_node_graph" "test" {
+  title       = "Test"
+  description = "Node graph description"
+
+  nodes {
+    main_stat_unit      = "ms"
+    secondary_stat_unit = "reqps"
+
+    arc {
+      field = "arc__success"
+      color = "green"
+    }
+
+    arc {
+      field = "arc__failed"
+      color = "red"
+    }
+  }
+
+  edges {
+    main_stat_unit      = "reqps"
+    secondary_stat_unit = "percentunit"
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "sum by (client, server) (rate(traces_service_graph_request_total[$__rate_interval]))"
+      ref_id  = "Prometheus_Query"
+      instant = true
+      format  = "table"
+    }
+
+    tempo {
+      uid = "tempo"
+
+      service_graph {
+        filter            = "{server=\"backend\"}"
+        include_namespace = true
+      }
+    }
+  }
+}
+`
+
+const testAccNodeGraphDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Node graph description",
+  "transparent": false,
+  "type": "nodeGraph",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum by (client, server) (rate(traces_service_graph_request_total[$__rate_interval]))",
+      "instant": true,
+      "format": "table"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "tempo",
+        "name": "",
+        "type": "tempo",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "serviceMap",
+      "serviceMapQuery": "{server=\"backend\"}",
+      "serviceMapIncludeNamespace": true
+    }
+  ],
+  "options": {
+    "nodes": {
+      "mainStatUnit": "ms",
+      "secondaryStatUnit": "reqps",
+      "arcs": [
+        {
+          "field": "arc__success",
+          "color": "green"
+        },
+        {
+          "field": "arc__failed",
+          "color": "red"
+        }
+      ]
+    },
+    "edges": {
+      "mainStatUnit": "reqps",
+      "secondaryStatUnit": "percentunit"
+    }
+  }
+}`
+
+const testAccNodeGraphDataSourceProviderDefaultsConfig = `
+data "gdashboard_node_graph" "test" {
+  title = "Test"
+}
+`
+
+const testAccNodeGraphDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "nodeGraph",
+  "options": {
+    "nodes": {
+      "arcs": []
+    },
+    "edges": {}
+  }
+}`
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &PanelDataSource{}
+
+func NewPanelDataSource() datasource.DataSource {
+	return &PanelDataSource{}
+}
+
+// PanelDataSource defines the data source implementation.
+type PanelDataSource struct {
+	CompactJson bool
+}
+
+// PanelDataSourceModel describes the data source data model.
+type PanelDataSourceModel struct {
+	Id                types.String           `tfsdk:"id"`
+	Json              types.String           `tfsdk:"json"`
+	CompactJson       types.Bool             `tfsdk:"compact_json"`
+	Title             types.String           `tfsdk:"title"`
+	Description       types.String           `tfsdk:"description"`
+	Type              types.String           `tfsdk:"type"`
+	Options           types.String           `tfsdk:"options"`
+	FieldConfigCustom types.String           `tfsdk:"field_config_custom"`
+	Queries           []Query                `tfsdk:"queries"`
+	Field             []FieldOptions         `tfsdk:"field"`
+	Overrides         []FieldOverrideOptions `tfsdk:"overrides"`
+	Transformations   []Transformations      `tfsdk:"transform"`
+}
+
+func (d *PanelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_panel"
+}
+
+func (d *PanelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Generic panel data source. Can be used to configure any panel plugin that has no dedicated data source.",
+		MarkdownDescription: "Generic panel data source. Can be used to configure any panel plugin that has no dedicated data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"field":     fieldBlock(false),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the panel plugin. For example: grafana-clock-panel.",
+				MarkdownDescription: "The ID of the panel plugin. For example: `grafana-clock-panel`.",
+			},
+			"options": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The JSON-encoded options of the panel plugin. Use jsonencode to build the value.",
+				MarkdownDescription: "The JSON-encoded options of the panel plugin. Use `jsonencode` to build the value.",
+			},
+			"field_config_custom": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The JSON-encoded custom field options of the panel plugin. Use jsonencode to build the value.",
+				MarkdownDescription: "The JSON-encoded custom field options of the panel plugin. Use `jsonencode` to build the value.",
+			},
+		},
+	}
+}
+
+func (d *PanelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+}
+
+func (d *PanelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanelDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var options interface{} = map[string]interface{}{}
+	var fieldConfigCustom interface{} = map[string]interface{}{}
+
+	if !data.Options.IsNull() {
+		if err := json.Unmarshal([]byte(data.Options.ValueString()), &options); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid JSON", fmt.Sprintf("Could not unmarshal options: %s", err))
+		}
+	}
+
+	if !data.FieldConfigCustom.IsNull() {
+		if err := json.Unmarshal([]byte(data.FieldConfigCustom.ValueString()), &fieldConfigCustom); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("field_config_custom"), "Invalid JSON", fmt.Sprintf("Could not unmarshal custom field options: %s", err))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	fieldConfig := createFieldConfig(NewFieldDefaults(), data.Field)
+	transformations := createTransformations(data.Transformations)
+
+	customPanel := grafana.CustomPanel{
+		"options": options,
+		"fieldConfig": grafana.CustomFieldConfig{
+			Defaults: grafana.CustomFieldConfigDefaults{
+				FieldConfigDefaults: fieldConfig,
+				Custom:              fieldConfigCustom,
+			},
+			Overrides: createOverrides(data.Overrides),
+		},
+	}
+
+	if len(targets) > 0 {
+		customPanel["targets"] = targets
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.CustomType,
+			Title:           data.Title.ValueString(),
+			Type:            data.Type.ValueString(),
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		CustomPanel: &customPanel,
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPanelDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPanelDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_panel.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_panel.test", "json", testAccPanelDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccPanelDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_panel.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_panel.test", "json", testAccPanelDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccPanelDataSourceConfig = `
+data "gdashboard_panel" "test" {
+  title       = "Test"
+  description = "Panel description"
+  type        = "grafana-clock-panel"
+
+  options = jsonencode({
+    mode     = "countdown"
+    timezone = "utc"
+  })
+
+  field_config_custom = jsonencode({
+    width = 100
+  })
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "sum(up)"
+    }
+  }
+
+  field {
+    unit     = "s"
+    decimals = 1
+  }
+
+  overrides {
+    by_name {
+      name = "up"
+      field {
+        unit = "percent"
+      }
+    }
+  }
+
+  transform {
+    step {
+      sort_by {
+        field = "up"
+      }
+    }
+  }
+}
+`
+
+const testAccPanelDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Panel description",
+  "transparent": false,
+  "type": "grafana-clock-panel",
+  "transformations": [
+    {
+      "id": "sortBy",
+      "options": {
+        "sort": [
+          {
+            "desc": false,
+            "field": "up"
+          }
+        ]
+      }
+    }
+  ],
+  "fieldConfig": {
+    "defaults": {
+      "unit": "s",
+      "decimals": 1,
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "width": 100
+      }
+    },
+    "overrides": [
+      {
+        "matcher": {
+          "id": "byName",
+          "options": "up"
+        },
+        "properties": [
+          {
+            "id": "unit",
+            "value": "percent"
+          }
+        ]
+      }
+    ]
+  },
+  "options": {
+    "mode": "countdown",
+    "timezone": "utc"
+  },
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum(up)"
+    }
+  ]
+}`
+
+const testAccPanelDataSourceProviderDefaultsConfig = `
+data "gdashboard_panel" "test" {
+  title = "Test"
+  type  = "grafana-clock-panel"
+}
+`
+
+const testAccPanelDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "grafana-clock-panel",
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {}
+    }
+  },
+  "options": {}
+}`
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &PieChartDataSource{}
+
+func NewPieChartDataSource() datasource.DataSource {
+	return &PieChartDataSource{}
+}
+
+// PieChartDataSource defines the data source implementation.
+type PieChartDataSource struct {
+	CompactJson bool
+	Defaults    PieChartDefaults
+}
+
+type PieChartDefaults struct {
+	Legend  PieChartLegendDefaults
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Graph   PieChartGraphDefaults
+}
+
+type PieChartLegendDefaults struct {
+	DisplayMode string
+	Placement   string
+	Values      []string
+}
+
+type PieChartGraphDefaults struct {
+	PieType       string
+	Labels        []string
+	ReduceOptions ReduceOptionDefaults
+}
+
+// PieChartDataSourceModel describes the data source data model.
+type PieChartDataSourceModel struct {
+	Id              types.String               `tfsdk:"id"`
+	Json            types.String               `tfsdk:"json"`
+	CompactJson     types.Bool                 `tfsdk:"compact_json"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Queries         []Query                    `tfsdk:"queries"`
+	Legend          []PieChartLegendOptions    `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field           []FieldOptions             `tfsdk:"field"`
+	Graph           []PieChartOptions          `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions     `tfsdk:"overrides"`
+	Transformations []Transformations          `tfsdk:"transform"`
+}
+
+type PieChartLegendOptions struct {
+	DisplayMode types.String   `tfsdk:"display_mode"`
+	Placement   types.String   `tfsdk:"placement"`
+	Values      []types.String `tfsdk:"values"`
+}
+
+type PieChartOptions struct {
+	PieType       types.String    `tfsdk:"pie_type"`
+	Labels        []types.String  `tfsdk:"labels"`
+	ReduceOptions []ReduceOptions `tfsdk:"options"`
+}
+
+func (d *PieChartDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_piechart"
+}
+
+func pieChartLegendBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Legend options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"display_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to display the legend. The choices are: list, table, hidden.",
+					MarkdownDescription: "Choose how to display the legend. The choices are: `list`, `table`, `hidden`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("list", "table", "hidden"),
+					},
+				},
+				"placement": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose where to display the legend. The choice are: bottom, right.",
+					MarkdownDescription: "Choose where to display the legend. The choice are: `bottom`, `right`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("bottom", "right"),
+					},
+				},
+				"values": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					Description:         "Choose which values to show in the legend. The choices are: value, percent.",
+					MarkdownDescription: "Choose which values to show in the legend. The choices are: `value`, `percent`.",
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(stringvalidator.OneOf("value", "percent")),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func pieChartGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"options": reduceOptionsBlock(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"pie_type": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The style of the chart. The choices are: pie, donut.",
+					MarkdownDescription: "The style of the chart. The choices are: `pie`, `donut`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("pie", "donut"),
+					},
+				},
+				"labels": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					Description:         "Choose which labels to show on the chart. The choices are: name, value, percent.",
+					MarkdownDescription: "Choose which labels to show on the chart. The choices are: `name`, `value`, `percent`.",
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(stringvalidator.OneOf("name", "value", "percent")),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *PieChartDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Pie chart panel data source.",
+		MarkdownDescription: "Pie chart panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/pie-chart/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    pieChartLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"graph":     pieChartGraphBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *PieChartDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.PieChart
+}
+
+func (d *PieChartDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PieChartDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+	transformations := createTransformations(data.Transformations)
+
+	options := grafana.PieChartOptions{
+		PieType:       d.Defaults.Graph.PieType,
+		DisplayLabels: d.Defaults.Graph.Labels,
+		Legend: grafana.PieChartLegendOptions{
+			DisplayMode: d.Defaults.Legend.DisplayMode,
+			Placement:   d.Defaults.Legend.Placement,
+			Values:      d.Defaults.Legend.Values,
+		},
+		Tooltip: grafana.TimeseriesTooltipOptions{
+			Mode: d.Defaults.Tooltip.Mode,
+		},
+		ReduceOptions: grafana.ReduceOptions{
+			Values: d.Defaults.Graph.ReduceOptions.Values,
+			Fields: d.Defaults.Graph.ReduceOptions.Fields,
+			Limit:  d.Defaults.Graph.ReduceOptions.Limit,
+			Calcs:  []string{d.Defaults.Graph.ReduceOptions.Calculation},
+		},
+	}
+
+	for _, legend := range data.Legend {
+		if !legend.DisplayMode.IsNull() {
+			options.Legend.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			options.Legend.Placement = legend.Placement.ValueString()
+		}
+
+		if len(legend.Values) > 0 {
+			values := make([]string, len(legend.Values))
+			for i, value := range legend.Values {
+				values[i] = value.ValueString()
+			}
+
+			options.Legend.Values = values
+		}
+	}
+
+	for _, tooltip := range data.Tooltip {
+		options.Tooltip.Mode = tooltip.Mode.ValueString()
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.PieType.IsNull() {
+			options.PieType = graph.PieType.ValueString()
+		}
+
+		if len(graph.Labels) > 0 {
+			labels := make([]string, len(graph.Labels))
+			for i, label := range graph.Labels {
+				labels[i] = label.ValueString()
+			}
+
+			options.DisplayLabels = labels
+		}
+
+		updateReduceOptions(&options.ReduceOptions, graph.ReduceOptions)
+	}
+
+	options.Legend.ShowLegend = options.Legend.DisplayMode != "hidden"
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.PieChartType,
+			Title:           data.Title.ValueString(),
+			Type:            "piechart",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		PieChartPanel: &grafana.PieChartPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPieChartDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPieChartDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "json", testAccPieChartDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccPieChartDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "json", testAccPieChartDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccPieChartDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_piechart.test", "json", testAccPieChartDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccPieChartDataSourceConfig = `
+data "gdashboard_piechart" "test" {
+  title       = "Test"
+  description = "Pie chart description"
+
+  legend {
+    display_mode = "table"
+    placement    = "right"
+    values       = ["value", "percent"]
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit     = "bytes"
+    decimals = 1
+  }
+
+  graph {
+    pie_type = "donut"
+    labels   = ["name", "percent"]
+
+    options {
+      values      = false
+      fields      = "/.*/"
+      calculation = "sum"
+    }
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum by (pod) (container_memory_usage_bytes)"
+      ref_id = "Prometheus_Query"
+    }
+
+    testdata {
+      uid    = "testdata"
+      ref_id = "Demo"
+
+      raw_frames {
+        content = jsonencode([
+          {
+            fields = [
+              { name = "pod", values = ["api", "worker"] },
+              { name = "memory", values = [512, 1024] },
+            ]
+          }
+        ])
+      }
+    }
+  }
+}
+`
+
+const testAccPieChartDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Pie chart description",
+  "transparent": false,
+  "type": "piechart",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum by (pod) (container_memory_usage_bytes)"
+    },
+    {
+      "refId": "Demo",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "scenarioId": "raw_frame",
+      "rawFrameContent": "[{\"fields\":[{\"name\":\"pod\",\"values\":[\"api\",\"worker\"]},{\"name\":\"memory\",\"values\":[512,1024]}]}]"
+    }
+  ],
+  "options": {
+    "pieType": "donut",
+    "displayLabels": [
+      "name",
+      "percent"
+    ],
+    "legend": {
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true,
+      "values": [
+        "value",
+        "percent"
+      ]
+    },
+    "tooltip": {
+      "mode": "multi"
+    },
+    "reduceOptions": {
+      "values": false,
+      "fields": "/.*/",
+      "calcs": [
+        "sum"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "bytes",
+      "decimals": 1,
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccPieChartDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    piechart {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "percent"
+      }
+
+      graph {
+        pie_type = "donut"
+        labels   = ["value"]
+
+        options {
+          calculation = "mean"
+        }
+      }
+    }
+  }
+}
+
+data "gdashboard_piechart" "test" {
+  title = "Test"
+}
+`
+
+const testAccPieChartDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "piechart",
+  "options": {
+    "pieType": "donut",
+    "displayLabels": [
+      "value"
+    ],
+    "legend": {
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false,
+      "values": []
+    },
+    "tooltip": {
+      "mode": "hidden"
+    },
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "mean"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccPieChartDataSourceProviderDefaultsConfig = `
+data "gdashboard_piechart" "test" {
+  title = "Test"
+}
+`
+
+const testAccPieChartDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "piechart",
+  "options": {
+    "pieType": "pie",
+    "displayLabels": [],
+    "legend": {
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true,
+      "values": []
+    },
+    "tooltip": {
+      "mode": "single"
+    },
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -23,13 +23,23 @@ type GrafanaDashboardBuilderProvider struct {
 }
 
 type Defaults struct {
-	CompactJson bool
-	Dashboard   DashboardDefaults
-	Timeseries  TimeseriesDefaults
-	BarGauge    BarGaugeDefaults
-	Stat        StatDefaults
-	Gauge       GaugeDefaults
-	Table       TableDefaults
+	CompactJson   bool
+	Dashboard     DashboardDefaults
+	Timeseries    TimeseriesDefaults
+	BarGauge      BarGaugeDefaults
+	Stat          StatDefaults
+	Gauge         GaugeDefaults
+	Table         TableDefaults
+	Heatmap       HeatmapDefaults
+	PieChart      PieChartDefaults
+	StateTimeline StateTimelineDefaults
+	StatusHistory StatusHistoryDefaults
+	BarChart      BarChartDefaults
+	Histogram     HistogramDefaults
+	XYChart       XYChartDefaults
+	Geomap        GeomapDefaults
+	Candlestick   CandlestickDefaults
+	Canvas        CanvasDefaults
 }
 
 // GrafanaDashboardBuilderProviderModel describes the provider data model.
@@ -39,12 +49,22 @@ type GrafanaDashboardBuilderProviderModel struct {
 }
 
 type DefaultsModel struct {
-	Dashboard  []DashboardDefaultsModel  `tfsdk:"dashboard"`
-	Timeseries []TimeseriesDefaultsModel `tfsdk:"timeseries"`
-	BarGauge   []BarGaugeDefaultsModel   `tfsdk:"bar_gauge"`
-	Stat       []StatDefaultsModel       `tfsdk:"stat"`
-	Gauge      []GaugeDefaultsModel      `tfsdk:"gauge"`
-	Table      []TableDefaultsModel      `tfsdk:"table"`
+	Dashboard     []DashboardDefaultsModel     `tfsdk:"dashboard"`
+	Timeseries    []TimeseriesDefaultsModel    `tfsdk:"timeseries"`
+	BarGauge      []BarGaugeDefaultsModel      `tfsdk:"bar_gauge"`
+	Stat          []StatDefaultsModel          `tfsdk:"stat"`
+	Gauge         []GaugeDefaultsModel         `tfsdk:"gauge"`
+	Table         []TableDefaultsModel         `tfsdk:"table"`
+	Heatmap       []HeatmapDefaultsModel       `tfsdk:"heatmap"`
+	PieChart      []PieChartDefaultsModel      `tfsdk:"piechart"`
+	StateTimeline []StateTimelineDefaultsModel `tfsdk:"state_timeline"`
+	StatusHistory []StatusHistoryDefaultsModel `tfsdk:"status_history"`
+	BarChart      []BarChartDefaultsModel      `tfsdk:"bar_chart"`
+	Histogram     []HistogramDefaultsModel     `tfsdk:"histogram"`
+	XYChart       []XYChartDefaultsModel       `tfsdk:"xy_chart"`
+	Geomap        []GeomapDefaultsModel        `tfsdk:"geomap"`
+	Candlestick   []CandlestickDefaultsModel   `tfsdk:"candlestick"`
+	Canvas        []CanvasDefaultsModel        `tfsdk:"canvas"`
 }
 
 type DashboardDefaultsModel struct {
@@ -81,6 +101,78 @@ type TableDefaultsModel struct {
 	Field []FieldOptions `tfsdk:"field"`
 }
 
+type HeatmapDefaultsModel struct {
+	Legend  []HeatmapLegendOptions  `tfsdk:"legend"`
+	Tooltip []HeatmapTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions          `tfsdk:"field"`
+	Graph   []HeatmapGraphOptions   `tfsdk:"graph"`
+}
+
+type PieChartDefaultsModel struct {
+	Legend  []PieChartLegendOptions    `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions             `tfsdk:"field"`
+	Graph   []PieChartOptions          `tfsdk:"graph"`
+}
+
+type StateTimelineDefaultsModel struct {
+	Legend  []StateTimelineLegendOptions `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions   `tfsdk:"tooltip"`
+	Field   []FieldOptions               `tfsdk:"field"`
+	Graph   []StateTimelineGraphOptions  `tfsdk:"graph"`
+}
+
+type StatusHistoryDefaultsModel struct {
+	Legend  []StateTimelineLegendOptions `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions   `tfsdk:"tooltip"`
+	Field   []FieldOptions               `tfsdk:"field"`
+	Graph   []StatusHistoryGraphOptions  `tfsdk:"graph"`
+}
+
+type BarChartDefaultsModel struct {
+	Legend  []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions             `tfsdk:"field"`
+	Axis    []AxisOptions              `tfsdk:"axis"`
+	Graph   []BarChartGraphOptions     `tfsdk:"graph"`
+}
+
+type HistogramDefaultsModel struct {
+	Legend  []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions             `tfsdk:"field"`
+	Graph   []HistogramGraphOptions    `tfsdk:"graph"`
+}
+
+type XYChartDefaultsModel struct {
+	Legend  []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions             `tfsdk:"field"`
+	Axis    []AxisOptions              `tfsdk:"axis"`
+	Graph   []XYChartGraphOptions      `tfsdk:"graph"`
+}
+
+type GeomapDefaultsModel struct {
+	Field     []FieldOptions           `tfsdk:"field"`
+	View      []GeomapViewOptions      `tfsdk:"view"`
+	Controls  []GeomapControlsOptions  `tfsdk:"controls"`
+	BaseLayer []GeomapBaseLayerOptions `tfsdk:"base_layer"`
+	Tooltip   []GeomapTooltipOptions   `tfsdk:"tooltip"`
+}
+
+type CandlestickDefaultsModel struct {
+	Legend  []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field   []FieldOptions             `tfsdk:"field"`
+	Axis    []AxisOptions              `tfsdk:"axis"`
+	Graph   []CandlestickGraphOptions  `tfsdk:"graph"`
+}
+
+type CanvasDefaultsModel struct {
+	Field []FieldOptions       `tfsdk:"field"`
+	Graph []CanvasGraphOptions `tfsdk:"graph"`
+}
+
 type TimeModel struct {
 	From types.String `tfsdk:"from"`
 	To   types.String `tfsdk:"to"`
@@ -180,6 +272,148 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 								listvalidator.SizeAtMost(1),
 							},
 						},
+						"heatmap": schema.ListNestedBlock{
+							Description: "Heatmap defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  heatmapLegendBlock(),
+									"tooltip": heatmapTooltipBlock(),
+									"field":   fieldBlock(false),
+									"graph":   heatmapGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"piechart": schema.ListNestedBlock{
+							Description: "Pie chart defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  pieChartLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"graph":   pieChartGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"state_timeline": schema.ListNestedBlock{
+							Description: "State timeline defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  stateTimelineLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"graph":   stateTimelineGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"status_history": schema.ListNestedBlock{
+							Description: "Status history defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  stateTimelineLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"graph":   statusHistoryGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"bar_chart": schema.ListNestedBlock{
+							Description: "Bar chart defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  timeseriesLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(true),
+									"axis":    axisBlock(),
+									"graph":   barChartGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"histogram": schema.ListNestedBlock{
+							Description: "Histogram defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  timeseriesLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"graph":   histogramGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"xy_chart": schema.ListNestedBlock{
+							Description: "XY chart defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  timeseriesLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"axis":    axisBlock(),
+									"graph":   xyChartGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"geomap": schema.ListNestedBlock{
+							Description: "Geomap defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"field":      fieldBlock(false),
+									"view":       geomapViewBlock(),
+									"controls":   geomapControlsBlock(),
+									"base_layer": geomapBaseLayerBlock(),
+									"tooltip":    geomapTooltipBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"candlestick": schema.ListNestedBlock{
+							Description: "Candlestick defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"legend":  timeseriesLegendBlock(),
+									"tooltip": timeseriesTooltipBlock(),
+									"field":   fieldBlock(false),
+									"axis":    axisBlock(),
+									"graph":   candlestickGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"canvas": schema.ListNestedBlock{
+							Description: "Canvas defaults.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"field": fieldBlock(false),
+									"graph": canvasGraphBlock(),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -220,16 +454,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				Mode: "single",
 			},
 			Field: NewFieldDefaults(),
-			Axis: AxisDefaults{
-				Label:     "",
-				Placement: "auto",
-				SoftMin:   nil,
-				SoftMax:   nil,
-				Scale: ScaleDefaults{
-					Type: "linear",
-					Log:  0,
-				},
-			},
+			Axis:  NewAxisDefaults(),
 			Graph: TimeseriesGraphDefault{
 				DrawStyle:         "line",
 				LineInterpolation: "linear",
@@ -273,6 +498,194 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 		Table: TableDefaults{
 			Field: NewFieldDefaults(),
 		},
+		Heatmap: HeatmapDefaults{
+			Legend: HeatmapLegendDefaults{
+				Show: true,
+			},
+			Tooltip: HeatmapTooltipDefaults{
+				Show:          true,
+				ShowHistogram: false,
+			},
+			Field: NewFieldDefaults(),
+			Graph: HeatmapGraphDefaults{
+				YBucketBound: "auto",
+				CellGap:      1,
+				ShowValues:   "auto",
+				Color: HeatmapColorDefaults{
+					Mode:     "scheme",
+					Scheme:   "Oranges",
+					Fill:     "dark-orange",
+					Scale:    "exponential",
+					Exponent: 0.5,
+					Steps:    64,
+					Reverse:  false,
+				},
+				ExemplarsColor: "rgba(255,0,255,0.7)",
+			},
+		},
+		PieChart: PieChartDefaults{
+			Legend: PieChartLegendDefaults{
+				DisplayMode: "list",
+				Placement:   "bottom",
+				Values:      []string{},
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Graph: PieChartGraphDefaults{
+				PieType:       "pie",
+				Labels:        []string{},
+				ReduceOptions: NewReduceOptionDefaults(),
+			},
+		},
+		StateTimeline: StateTimelineDefaults{
+			Legend: StateTimelineLegendDefaults{
+				DisplayMode: "list",
+				Placement:   "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Graph: StateTimelineGraphDefaults{
+				MergeValues: true,
+				ShowValues:  "auto",
+				RowHeight:   0.9,
+				LineWidth:   0,
+				FillOpacity: 70,
+			},
+		},
+		StatusHistory: StatusHistoryDefaults{
+			Legend: StateTimelineLegendDefaults{
+				DisplayMode: "list",
+				Placement:   "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Graph: StatusHistoryGraphDefaults{
+				ShowValues:  "auto",
+				RowHeight:   0.9,
+				ColumnWidth: 0.9,
+				FillOpacity: 70,
+			},
+		},
+		BarChart: BarChartDefaults{
+			Legend: TimeseriesLegendDefault{
+				Calculations: []string{},
+				DisplayMode:  "list",
+				Placement:    "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Axis:  NewAxisDefaults(),
+			Graph: BarChartGraphDefaults{
+				Orientation:        "auto",
+				XField:             "",
+				GroupWidth:         0.7,
+				BarWidth:           0.97,
+				BarRadius:          0,
+				Stacking:           "none",
+				ShowValues:         "auto",
+				ValueLabelRotation: 0,
+			},
+		},
+		Histogram: HistogramDefaults{
+			Legend: TimeseriesLegendDefault{
+				Calculations: []string{},
+				DisplayMode:  "list",
+				Placement:    "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Graph: HistogramGraphDefaults{
+				BucketSize:    nil,
+				BucketOffset:  nil,
+				BucketCount:   nil,
+				CombineSeries: false,
+				FillOpacity:   80,
+				GradientMode:  "none",
+				StackSeries:   "none",
+			},
+		},
+		XYChart: XYChartDefaults{
+			Legend: TimeseriesLegendDefault{
+				Calculations: []string{},
+				DisplayMode:  "list",
+				Placement:    "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Axis:  NewAxisDefaults(),
+			Graph: XYChartGraphDefaults{
+				SeriesMapping: "auto",
+				XField:        "",
+				Show:          "points",
+				PointSize:     5,
+				PointSizeMin:  1,
+				PointSizeMax:  20,
+				LineWidth:     1,
+			},
+		},
+		Geomap: GeomapDefaults{
+			Field: NewFieldDefaults(),
+			View: GeomapViewDefaults{
+				FitToData: false,
+				Latitude:  0,
+				Longitude: 0,
+				Zoom:      1,
+			},
+			Controls: GeomapControlsDefaults{
+				ShowZoom:        true,
+				MouseWheelZoom:  true,
+				ShowAttribution: true,
+				ShowScale:       false,
+				ShowMeasure:     false,
+				ShowDebug:       false,
+			},
+			BaseLayer: GeomapBaseLayerDefaults{
+				Type: "default",
+			},
+			Tooltip: GeomapTooltipDefaults{
+				Mode: "details",
+			},
+		},
+		Candlestick: CandlestickDefaults{
+			Legend: TimeseriesLegendDefault{
+				Calculations: []string{},
+				DisplayMode:  "list",
+				Placement:    "bottom",
+			},
+			Tooltip: TimeseriesTooltipDefaults{
+				Mode: "single",
+			},
+			Field: NewFieldDefaults(),
+			Axis:  NewAxisDefaults(),
+			Graph: CandlestickGraphDefaults{
+				Mode:             "both",
+				CandleStyle:      "candles",
+				ColorStrategy:    "open_close",
+				UpColor:          "green",
+				DownColor:        "red",
+				IncludeAllFields: false,
+			},
+		},
+		Canvas: CanvasDefaults{
+			Field: NewFieldDefaults(),
+			Graph: CanvasGraphDefaults{
+				InlineEditing:     true,
+				ShowAdvancedTypes: true,
+				PanZoom:           false,
+			},
+		},
 	}
 
 	if len(data.Defaults) > 0 && len(data.Defaults[0].Dashboard) > 0 {
@@ -367,33 +780,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 			defaults.Timeseries.Tooltip.Mode = tooltip.Mode.ValueString()
 		}
 
-		for _, axis := range opts.Axis {
-			if !axis.Label.IsNull() {
-				defaults.Timeseries.Axis.Label = axis.Label.ValueString()
-			}
-
-			if !axis.Placement.IsNull() {
-				defaults.Timeseries.Axis.Placement = axis.Placement.ValueString()
-			}
-
-			if !axis.SoftMin.IsNull() {
-				defaults.Timeseries.Axis.SoftMin = axis.SoftMin.ValueFloat64Pointer()
-			}
-
-			if !axis.SoftMax.IsNull() {
-				defaults.Timeseries.Axis.SoftMax = axis.SoftMax.ValueFloat64Pointer()
-			}
-
-			for _, scale := range axis.Scale {
-				if !scale.Type.IsNull() {
-					defaults.Timeseries.Axis.Scale.Type = scale.Type.ValueString()
-				}
-
-				if !scale.Log.IsNull() {
-					defaults.Timeseries.Axis.Scale.Log = int(scale.Log.ValueInt64())
-				}
-			}
-		}
+		updateAxisDefaults(&defaults.Timeseries.Axis, opts.Axis)
 	}
 
 	if len(data.Defaults) > 0 && len(data.Defaults[0].BarGauge) > 0 {
@@ -479,6 +866,383 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 		updateFieldDefaults(&defaults.Table.Field, opts.Field)
 	}
 
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Heatmap) > 0 {
+		opts := data.Defaults[0].Heatmap[0]
+
+		updateFieldDefaults(&defaults.Heatmap.Field, opts.Field)
+
+		for _, graph := range opts.Graph {
+			updateHeatmapGraphDefaults(&defaults.Heatmap.Graph, graph)
+		}
+
+		for _, legend := range opts.Legend {
+			defaults.Heatmap.Legend.Show = legend.Show.ValueBool()
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			if !tooltip.Show.IsNull() {
+				defaults.Heatmap.Tooltip.Show = tooltip.Show.ValueBool()
+			}
+
+			if !tooltip.ShowHistogram.IsNull() {
+				defaults.Heatmap.Tooltip.ShowHistogram = tooltip.ShowHistogram.ValueBool()
+			}
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].PieChart) > 0 {
+		opts := data.Defaults[0].PieChart[0]
+
+		updateFieldDefaults(&defaults.PieChart.Field, opts.Field)
+
+		for _, legend := range opts.Legend {
+			if !legend.DisplayMode.IsNull() {
+				defaults.PieChart.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.PieChart.Legend.Placement = legend.Placement.ValueString()
+			}
+
+			if len(legend.Values) > 0 {
+				values := make([]string, len(legend.Values))
+				for i, value := range legend.Values {
+					values[i] = value.ValueString()
+				}
+
+				defaults.PieChart.Legend.Values = values
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.PieChart.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			if !graph.PieType.IsNull() {
+				defaults.PieChart.Graph.PieType = graph.PieType.ValueString()
+			}
+
+			if len(graph.Labels) > 0 {
+				labels := make([]string, len(graph.Labels))
+				for i, label := range graph.Labels {
+					labels[i] = label.ValueString()
+				}
+
+				defaults.PieChart.Graph.Labels = labels
+			}
+
+			updateReduceOptionsDefaults(&defaults.PieChart.Graph.ReduceOptions, graph.ReduceOptions)
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].StateTimeline) > 0 {
+		opts := data.Defaults[0].StateTimeline[0]
+
+		updateFieldDefaults(&defaults.StateTimeline.Field, opts.Field)
+
+		for _, legend := range opts.Legend {
+			if !legend.DisplayMode.IsNull() {
+				defaults.StateTimeline.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.StateTimeline.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.StateTimeline.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			if !graph.MergeValues.IsNull() {
+				defaults.StateTimeline.Graph.MergeValues = graph.MergeValues.ValueBool()
+			}
+
+			if !graph.ShowValues.IsNull() {
+				defaults.StateTimeline.Graph.ShowValues = graph.ShowValues.ValueString()
+			}
+
+			if !graph.RowHeight.IsNull() {
+				defaults.StateTimeline.Graph.RowHeight = graph.RowHeight.ValueFloat64()
+			}
+
+			if !graph.LineWidth.IsNull() {
+				defaults.StateTimeline.Graph.LineWidth = int(graph.LineWidth.ValueInt64())
+			}
+
+			if !graph.FillOpacity.IsNull() {
+				defaults.StateTimeline.Graph.FillOpacity = int(graph.FillOpacity.ValueInt64())
+			}
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].StatusHistory) > 0 {
+		opts := data.Defaults[0].StatusHistory[0]
+
+		updateFieldDefaults(&defaults.StatusHistory.Field, opts.Field)
+
+		for _, legend := range opts.Legend {
+			if !legend.DisplayMode.IsNull() {
+				defaults.StatusHistory.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.StatusHistory.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.StatusHistory.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			if !graph.ShowValues.IsNull() {
+				defaults.StatusHistory.Graph.ShowValues = graph.ShowValues.ValueString()
+			}
+
+			if !graph.RowHeight.IsNull() {
+				defaults.StatusHistory.Graph.RowHeight = graph.RowHeight.ValueFloat64()
+			}
+
+			if !graph.ColumnWidth.IsNull() {
+				defaults.StatusHistory.Graph.ColumnWidth = graph.ColumnWidth.ValueFloat64()
+			}
+
+			if !graph.FillOpacity.IsNull() {
+				defaults.StatusHistory.Graph.FillOpacity = int(graph.FillOpacity.ValueInt64())
+			}
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].BarChart) > 0 {
+		opts := data.Defaults[0].BarChart[0]
+
+		updateFieldDefaults(&defaults.BarChart.Field, opts.Field)
+		updateAxisDefaults(&defaults.BarChart.Axis, opts.Axis)
+
+		for _, legend := range opts.Legend {
+			if len(legend.Calculations) > 0 {
+				calculations := make([]string, len(legend.Calculations))
+
+				for i, c := range legend.Calculations {
+					calculations[i] = c.ValueString()
+				}
+
+				defaults.BarChart.Legend.Calculations = calculations
+			}
+
+			if !legend.DisplayMode.IsNull() {
+				defaults.BarChart.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.BarChart.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.BarChart.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			if !graph.Orientation.IsNull() {
+				defaults.BarChart.Graph.Orientation = graph.Orientation.ValueString()
+			}
+
+			if !graph.XField.IsNull() {
+				defaults.BarChart.Graph.XField = graph.XField.ValueString()
+			}
+
+			if !graph.GroupWidth.IsNull() {
+				defaults.BarChart.Graph.GroupWidth = graph.GroupWidth.ValueFloat64()
+			}
+
+			if !graph.BarWidth.IsNull() {
+				defaults.BarChart.Graph.BarWidth = graph.BarWidth.ValueFloat64()
+			}
+
+			if !graph.BarRadius.IsNull() {
+				defaults.BarChart.Graph.BarRadius = graph.BarRadius.ValueFloat64()
+			}
+
+			if !graph.Stacking.IsNull() {
+				defaults.BarChart.Graph.Stacking = graph.Stacking.ValueString()
+			}
+
+			if !graph.ShowValues.IsNull() {
+				defaults.BarChart.Graph.ShowValues = graph.ShowValues.ValueString()
+			}
+
+			if !graph.ValueLabelRotation.IsNull() {
+				defaults.BarChart.Graph.ValueLabelRotation = int(graph.ValueLabelRotation.ValueInt64())
+			}
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Histogram) > 0 {
+		opts := data.Defaults[0].Histogram[0]
+
+		updateFieldDefaults(&defaults.Histogram.Field, opts.Field)
+
+		for _, legend := range opts.Legend {
+			if len(legend.Calculations) > 0 {
+				calculations := make([]string, len(legend.Calculations))
+
+				for i, c := range legend.Calculations {
+					calculations[i] = c.ValueString()
+				}
+
+				defaults.Histogram.Legend.Calculations = calculations
+			}
+
+			if !legend.DisplayMode.IsNull() {
+				defaults.Histogram.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.Histogram.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.Histogram.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			if !graph.BucketSize.IsNull() {
+				defaults.Histogram.Graph.BucketSize = graph.BucketSize.ValueFloat64Pointer()
+			}
+
+			if !graph.BucketOffset.IsNull() {
+				defaults.Histogram.Graph.BucketOffset = graph.BucketOffset.ValueFloat64Pointer()
+			}
+
+			if !graph.BucketCount.IsNull() {
+				defaults.Histogram.Graph.BucketCount = graph.BucketCount.ValueInt64Pointer()
+			}
+
+			if !graph.CombineSeries.IsNull() {
+				defaults.Histogram.Graph.CombineSeries = graph.CombineSeries.ValueBool()
+			}
+
+			if !graph.FillOpacity.IsNull() {
+				defaults.Histogram.Graph.FillOpacity = int(graph.FillOpacity.ValueInt64())
+			}
+
+			if !graph.GradientMode.IsNull() {
+				defaults.Histogram.Graph.GradientMode = graph.GradientMode.ValueString()
+			}
+
+			if !graph.StackSeries.IsNull() {
+				defaults.Histogram.Graph.StackSeries = graph.StackSeries.ValueString()
+			}
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].XYChart) > 0 {
+		opts := data.Defaults[0].XYChart[0]
+
+		updateFieldDefaults(&defaults.XYChart.Field, opts.Field)
+		updateAxisDefaults(&defaults.XYChart.Axis, opts.Axis)
+
+		for _, legend := range opts.Legend {
+			if len(legend.Calculations) > 0 {
+				calculations := make([]string, len(legend.Calculations))
+
+				for i, c := range legend.Calculations {
+					calculations[i] = c.ValueString()
+				}
+
+				defaults.XYChart.Legend.Calculations = calculations
+			}
+
+			if !legend.DisplayMode.IsNull() {
+				defaults.XYChart.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.XYChart.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.XYChart.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			updateXYChartGraphDefaults(&defaults.XYChart.Graph, graph)
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Geomap) > 0 {
+		opts := data.Defaults[0].Geomap[0]
+
+		updateFieldDefaults(&defaults.Geomap.Field, opts.Field)
+
+		for _, view := range opts.View {
+			updateGeomapViewDefaults(&defaults.Geomap.View, view)
+		}
+
+		for _, controls := range opts.Controls {
+			updateGeomapControlsDefaults(&defaults.Geomap.Controls, controls)
+		}
+
+		for _, baseLayer := range opts.BaseLayer {
+			updateGeomapBaseLayerDefaults(&defaults.Geomap.BaseLayer, baseLayer)
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.Geomap.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Candlestick) > 0 {
+		opts := data.Defaults[0].Candlestick[0]
+
+		updateFieldDefaults(&defaults.Candlestick.Field, opts.Field)
+		updateAxisDefaults(&defaults.Candlestick.Axis, opts.Axis)
+
+		for _, legend := range opts.Legend {
+			if len(legend.Calculations) > 0 {
+				calculations := make([]string, len(legend.Calculations))
+
+				for i, c := range legend.Calculations {
+					calculations[i] = c.ValueString()
+				}
+
+				defaults.Candlestick.Legend.Calculations = calculations
+			}
+
+			if !legend.DisplayMode.IsNull() {
+				defaults.Candlestick.Legend.DisplayMode = legend.DisplayMode.ValueString()
+			}
+
+			if !legend.Placement.IsNull() {
+				defaults.Candlestick.Legend.Placement = legend.Placement.ValueString()
+			}
+		}
+
+		for _, tooltip := range opts.Tooltip {
+			defaults.Candlestick.Tooltip.Mode = tooltip.Mode.ValueString()
+		}
+
+		for _, graph := range opts.Graph {
+			updateCandlestickGraphDefaults(&defaults.Candlestick.Graph, graph)
+		}
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Canvas) > 0 {
+		opts := data.Defaults[0].Canvas[0]
+
+		updateFieldDefaults(&defaults.Canvas.Field, opts.Field)
+
+		for _, graph := range opts.Graph {
+			updateCanvasGraphDefaults(&defaults.Canvas.Graph, graph)
+		}
+	}
+
 	resp.DataSourceData = defaults
 	resp.ResourceData = defaults
 }
@@ -533,6 +1297,36 @@ func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 	}
 }
 
+func updateAxisDefaults(defaults *AxisDefaults, opts []AxisOptions) {
+	for _, axis := range opts {
+		if !axis.Label.IsNull() {
+			defaults.Label = axis.Label.ValueString()
+		}
+
+		if !axis.Placement.IsNull() {
+			defaults.Placement = axis.Placement.ValueString()
+		}
+
+		if !axis.SoftMin.IsNull() {
+			defaults.SoftMin = axis.SoftMin.ValueFloat64Pointer()
+		}
+
+		if !axis.SoftMax.IsNull() {
+			defaults.SoftMax = axis.SoftMax.ValueFloat64Pointer()
+		}
+
+		for _, scale := range axis.Scale {
+			if !scale.Type.IsNull() {
+				defaults.Scale.Type = scale.Type.ValueString()
+			}
+
+			if !scale.Log.IsNull() {
+				defaults.Scale.Log = int(scale.Log.ValueInt64())
+			}
+		}
+	}
+}
+
 func updateTextSizeDefaults(defaults *TextSizeDefaults, opts []TextSizeOptions) {
 	for _, textSize := range opts {
 		defaults.Title = textSize.Title.ValueInt64Pointer()
@@ -574,6 +1368,24 @@ func (p *GrafanaDashboardBuilderProvider) DataSources(ctx context.Context) []fun
 		NewTextDataSource,
 		NewTableDataSource,
 		NewLogsDataSource,
+		NewHeatmapDataSource,
+		NewPieChartDataSource,
+		NewStateTimelineDataSource,
+		NewStatusHistoryDataSource,
+		NewBarChartDataSource,
+		NewHistogramDataSource,
+		NewXYChartDataSource,
+		NewGeomapDataSource,
+		NewDashboardListDataSource,
+		NewAlertListDataSource,
+		NewNodeGraphDataSource,
+		NewTracesDataSource,
+		NewCandlestickDataSource,
+		NewCanvasDataSource,
+		NewFlameGraphDataSource,
+		NewNewsDataSource,
+		NewAnnotationsListDataSource,
+		NewPanelDataSource,
 	}
 }
 
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -19,6 +20,20 @@ func TestAccStatDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccStatDataSourceExpressionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceExpressionConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatDataSourceInfluxDBConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceInfluxDBConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccStatDataSourceProviderCustomDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -33,6 +48,22 @@ func TestAccStatDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceProviderDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config:      testAccStatDataSourceThresholdParamsConfig,
+				ExpectError: regexp.MustCompile(`The evaluator "gt" requires 1 value\(s\), got: 2`),
+			},
+			{
+				Config:      testAccStatDataSourceHysteresisParamsConfig,
+				ExpectError: regexp.MustCompile(`The evaluator "within_range" requires 2 value\(s\), got: 1`),
+			},
+			{
+				Config:      testAccStatDataSourceClassicConditionParamsConfig,
+				ExpectError: regexp.MustCompile(`The evaluator "lt" requires 1 value\(s\), got: 0`),
+			},
+			{
+				Config:      testAccStatDataSourceInfluxDBMissingQueryConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
 		},
 	})
 }
@@ -95,6 +126,78 @@ data "gdashboard_stat" "test" {
 }
 `
 
+const testAccStatDataSourceThresholdParamsConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    expression {
+      ref_id = "Threshold"
+
+      threshold {
+        input     = "A"
+        evaluator = "gt"
+        params    = [90, 100]
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceHysteresisParamsConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    expression {
+      ref_id = "Threshold"
+
+      threshold {
+        input     = "A"
+        evaluator = "within_range"
+        params    = [90, 100]
+
+        hysteresis {
+          params = [95]
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceClassicConditionParamsConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    expression {
+      ref_id = "Classic"
+
+      classic_conditions {
+        condition {
+          query     = "A"
+          reducer   = "last"
+          evaluator = "lt"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceInfluxDBMissingQueryConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    influxdb {
+      uid = "influxdb"
+    }
+  }
+}
+`
+
 const testAccStatDataSourceConfigExpectedJson = `{
   "editable": false,
   "error": false,
@@ -244,6 +347,471 @@ const testAccStatDataSourceConfigExpectedJson = `{
   }
 }`
 
+const testAccStatDataSourceExpressionConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "up{container_name='container'}"
+      instant = true
+      ref_id  = "A"
+    }
+
+    expression {
+      ref_id = "Threshold"
+
+      threshold {
+        input     = "A"
+        evaluator = "gt"
+        params    = [99.5]
+
+        hysteresis {
+          params = [99]
+        }
+      }
+    }
+
+    expression {
+      ref_id = "Range"
+      hide   = true
+
+      threshold {
+        input     = "A"
+        evaluator = "outside_range"
+        params    = [90, 100]
+      }
+    }
+
+    expression {
+      ref_id = "Classic"
+
+      classic_conditions {
+        condition {
+          query     = "A"
+          reducer   = "last"
+          evaluator = "lt"
+          params    = [99]
+        }
+
+        condition {
+          operator  = "or"
+          query     = "A"
+          reducer   = "count_non_null"
+          evaluator = "no_value"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceExpressionConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "up{container_name='container'}",
+      "instant": true
+    },
+    {
+      "refId": "Threshold",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "__expr__",
+        "name": "Expression",
+        "type": "__expr__",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "type": "threshold",
+      "expression": "A",
+      "conditions": [
+        {
+          "evaluator": {
+            "type": "gt",
+            "params": [
+              99.5
+            ]
+          },
+          "unloadEvaluator": {
+            "type": "lt",
+            "params": [
+              99
+            ]
+          }
+        }
+      ]
+    },
+    {
+      "refId": "Range",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "__expr__",
+        "name": "Expression",
+        "type": "__expr__",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "type": "threshold",
+      "expression": "A",
+      "conditions": [
+        {
+          "evaluator": {
+            "type": "outside_range",
+            "params": [
+              90,
+              100
+            ]
+          }
+        }
+      ]
+    },
+    {
+      "refId": "Classic",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "__expr__",
+        "name": "Expression",
+        "type": "__expr__",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "type": "classic_conditions",
+      "conditions": [
+        {
+          "type": "query",
+          "query": {
+            "params": [
+              "A"
+            ]
+          },
+          "reducer": {
+            "type": "last",
+            "params": []
+          },
+          "evaluator": {
+            "type": "lt",
+            "params": [
+              99
+            ]
+          },
+          "operator": {
+            "type": "and"
+          }
+        },
+        {
+          "type": "query",
+          "query": {
+            "params": [
+              "A"
+            ]
+          },
+          "reducer": {
+            "type": "count_non_null",
+            "params": []
+          },
+          "evaluator": {
+            "type": "no_value",
+            "params": []
+          },
+          "operator": {
+            "type": "or"
+          }
+        }
+      ]
+    }
+  ],
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "auto",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStatDataSourceInfluxDBConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  queries {
+    influxdb {
+      uid    = "influxdb"
+      ref_id = "InfluxQL"
+
+      influxql {
+        query         = "SELECT last(\"value\") FROM \"uptime\" WHERE $timeFilter GROUP BY \"host\""
+        result_format = "table"
+        alias         = "$tag_host"
+      }
+    }
+
+    influxdb {
+      uid  = "influxdb-flux"
+      hide = true
+
+      flux {
+        query = "from(bucket: \"metrics\") |> range(start: v.timeRangeStart) |> last()"
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceInfluxDBConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "targets": [
+    {
+      "refId": "InfluxQL",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "influxdb",
+        "name": "",
+        "type": "influxdb",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "query": "SELECT last(\"value\") FROM \"uptime\" WHERE $timeFilter GROUP BY \"host\"",
+      "alias": "$tag_host",
+      "rawQuery": true,
+      "resultFormat": "table"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "influxdb-flux",
+        "name": "",
+        "type": "influxdb",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "query": "from(bucket: \"metrics\") |\u003e range(start: v.timeRangeStart) |\u003e last()"
+    }
+  ],
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "auto",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
 const testAccStatDataSourceProviderCustomDefaultsConfig = `
 provider "gdashboard" {
   defaults {
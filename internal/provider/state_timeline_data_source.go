@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StateTimelineDataSource{}
+
+func NewStateTimelineDataSource() datasource.DataSource {
+	return &StateTimelineDataSource{}
+}
+
+// StateTimelineDataSource defines the data source implementation.
+type StateTimelineDataSource struct {
+	CompactJson bool
+	Defaults    StateTimelineDefaults
+}
+
+type StateTimelineDefaults struct {
+	Legend  StateTimelineLegendDefaults
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Graph   StateTimelineGraphDefaults
+}
+
+type StateTimelineLegendDefaults struct {
+	DisplayMode string
+	Placement   string
+}
+
+type StateTimelineGraphDefaults struct {
+	MergeValues bool
+	ShowValues  string
+	RowHeight   float64
+	LineWidth   int
+	FillOpacity int
+}
+
+// StateTimelineDataSourceModel describes the data source data model.
+type StateTimelineDataSourceModel struct {
+	Id              types.String                 `tfsdk:"id"`
+	Json            types.String                 `tfsdk:"json"`
+	CompactJson     types.Bool                   `tfsdk:"compact_json"`
+	Title           types.String                 `tfsdk:"title"`
+	Description     types.String                 `tfsdk:"description"`
+	Queries         []Query                      `tfsdk:"queries"`
+	Legend          []StateTimelineLegendOptions `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions   `tfsdk:"tooltip"`
+	Field           []FieldOptions               `tfsdk:"field"`
+	Graph           []StateTimelineGraphOptions  `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions       `tfsdk:"overrides"`
+	Transformations []Transformations            `tfsdk:"transform"`
+}
+
+type StateTimelineLegendOptions struct {
+	DisplayMode types.String `tfsdk:"display_mode"`
+	Placement   types.String `tfsdk:"placement"`
+}
+
+type StateTimelineGraphOptions struct {
+	MergeValues types.Bool    `tfsdk:"merge_values"`
+	ShowValues  types.String  `tfsdk:"show_values"`
+	RowHeight   types.Float64 `tfsdk:"row_height"`
+	LineWidth   types.Int64   `tfsdk:"line_width"`
+	FillOpacity types.Int64   `tfsdk:"fill_opacity"`
+}
+
+func (d *StateTimelineDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_timeline"
+}
+
+func stateTimelineLegendBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Legend options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"display_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to display the legend. The choices are: list, table, hidden.",
+					MarkdownDescription: "Choose how to display the legend. The choices are: `list`, `table`, `hidden`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("list", "table", "hidden"),
+					},
+				},
+				"placement": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose where to display the legend. The choice are: bottom, right.",
+					MarkdownDescription: "Choose where to display the legend. The choice are: `bottom`, `right`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("bottom", "right"),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func stateTimelineGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"merge_values": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to merge equal consecutive values or not.",
+				},
+				"show_values": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Controls whether values are rendered inside the state regions. The choices are: auto, always, never.",
+					MarkdownDescription: "Controls whether values are rendered inside the state regions. The choices are: `auto`, `always`, `never`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "always", "never"),
+					},
+				},
+				"row_height": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "Controls how much space between rows there are. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "Controls how much space between rows there are. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"line_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The width of the line around the state regions. Must be between 0 and 10 (inclusive).",
+					MarkdownDescription: "The width of the line around the state regions. Must be between `0` and `10` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 10),
+					},
+				},
+				"fill_opacity": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The opacity of the state regions. Must be between 0 and 100 (inclusive).",
+					MarkdownDescription: "The opacity of the state regions. Must be between `0` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 100),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *StateTimelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "State timeline panel data source.",
+		MarkdownDescription: "State timeline panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/state-timeline/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    stateTimelineLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"graph":     stateTimelineGraphBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *StateTimelineDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.StateTimeline
+}
+
+func (d *StateTimelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StateTimelineDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       []string{},
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	options := grafana.StateTimelineOptions{
+		MergeValues: d.Defaults.Graph.MergeValues,
+		ShowValue:   d.Defaults.Graph.ShowValues,
+		AlignValue:  "left",
+		RowHeight:   d.Defaults.Graph.RowHeight,
+		Legend:      legendOptions,
+		Tooltip:     tooltipOptions,
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		LineWidth:   d.Defaults.Graph.LineWidth,
+		FillOpacity: d.Defaults.Graph.FillOpacity,
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.MergeValues.IsNull() {
+			options.MergeValues = graph.MergeValues.ValueBool()
+		}
+
+		if !graph.ShowValues.IsNull() {
+			options.ShowValue = graph.ShowValues.ValueString()
+		}
+
+		if !graph.RowHeight.IsNull() {
+			options.RowHeight = graph.RowHeight.ValueFloat64()
+		}
+
+		if !graph.LineWidth.IsNull() {
+			fieldConfig.Custom.LineWidth = int(graph.LineWidth.ValueInt64())
+		}
+
+		if !graph.FillOpacity.IsNull() {
+			fieldConfig.Custom.FillOpacity = int(graph.FillOpacity.ValueInt64())
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.StateTimelineType,
+			Title:           data.Title.ValueString(),
+			Type:            "state-timeline",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		StateTimelinePanel: &grafana.StateTimelinePanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
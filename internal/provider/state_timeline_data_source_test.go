@@ -0,0 +1,435 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStateTimelineDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStateTimelineDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "json", testAccStateTimelineDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStateTimelineDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "json", testAccStateTimelineDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStateTimelineDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_state_timeline.test", "json", testAccStateTimelineDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccStateTimelineDataSourceConfig = `
+data "gdashboard_state_timeline" "test" {
+  title       = "Test"
+  description = "State timeline description"
+
+  legend {
+    display_mode = "table"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    mappings {
+      value {
+        value        = "1"
+        display_text = "UP"
+        color        = "green"
+      }
+
+      value {
+        value        = "0"
+        display_text = "DOWN"
+        color        = "red"
+      }
+
+      special {
+        match        = "null"
+        display_text = "UNKNOWN"
+        color        = "gray"
+      }
+    }
+  }
+
+  graph {
+    merge_values = false
+    show_values  = "always"
+    row_height   = 0.8
+    line_width   = 1
+    fill_opacity = 80
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "up{job='api'}"
+      ref_id = "Prometheus_Query"
+    }
+
+    testdata {
+      uid    = "testdata"
+      alias  = "api"
+      ref_id = "Demo"
+
+      csv_metric_values {
+        values = [1, 1, 0, 1, 0.5]
+      }
+    }
+  }
+}
+`
+
+const testAccStateTimelineDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "State timeline description",
+  "transparent": false,
+  "type": "state-timeline",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "up{job='api'}"
+    },
+    {
+      "refId": "Demo",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "alias": "api",
+      "scenarioId": "csv_metric_values",
+      "stringInput": "1,1,0,1,0.5"
+    }
+  ],
+  "options": {
+    "mergeValues": false,
+    "showValue": "always",
+    "alignValue": "left",
+    "rowHeight": 0.8,
+    "legend": {
+      "calcs": [],
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 80,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      },
+      "mappings": [
+        {
+          "type": "value",
+          "options": {
+            "0": {
+              "color": "red",
+              "text": "DOWN",
+              "index": 1
+            },
+            "1": {
+              "color": "green",
+              "text": "UP",
+              "index": 0
+            }
+          }
+        },
+        {
+          "type": "special",
+          "options": {
+            "match": "null",
+            "result": {
+              "color": "gray",
+              "text": "UNKNOWN",
+              "index": 2
+            }
+          }
+        }
+      ]
+    }
+  }
+}`
+
+const testAccStateTimelineDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    state_timeline {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "short"
+      }
+
+      graph {
+        merge_values = false
+        show_values  = "never"
+        row_height   = 1
+        line_width   = 2
+        fill_opacity = 50
+      }
+    }
+  }
+}
+
+data "gdashboard_state_timeline" "test" {
+  title = "Test"
+}
+`
+
+const testAccStateTimelineDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "state-timeline",
+  "options": {
+    "mergeValues": false,
+    "showValue": "never",
+    "alignValue": "left",
+    "rowHeight": 1,
+    "legend": {
+      "calcs": [],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "short",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 50,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 2,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStateTimelineDataSourceProviderDefaultsConfig = `
+data "gdashboard_state_timeline" "test" {
+  title = "Test"
+}
+`
+
+const testAccStateTimelineDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "state-timeline",
+  "options": {
+    "mergeValues": true,
+    "showValue": "auto",
+    "alignValue": "left",
+    "rowHeight": 0.9,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 70,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StatusHistoryDataSource{}
+
+func NewStatusHistoryDataSource() datasource.DataSource {
+	return &StatusHistoryDataSource{}
+}
+
+// StatusHistoryDataSource defines the data source implementation.
+type StatusHistoryDataSource struct {
+	CompactJson bool
+	Defaults    StatusHistoryDefaults
+}
+
+type StatusHistoryDefaults struct {
+	Legend  StateTimelineLegendDefaults
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Graph   StatusHistoryGraphDefaults
+}
+
+type StatusHistoryGraphDefaults struct {
+	ShowValues  string
+	RowHeight   float64
+	ColumnWidth float64
+	FillOpacity int
+}
+
+// StatusHistoryDataSourceModel describes the data source data model.
+type StatusHistoryDataSourceModel struct {
+	Id              types.String                 `tfsdk:"id"`
+	Json            types.String                 `tfsdk:"json"`
+	CompactJson     types.Bool                   `tfsdk:"compact_json"`
+	Title           types.String                 `tfsdk:"title"`
+	Description     types.String                 `tfsdk:"description"`
+	Queries         []Query                      `tfsdk:"queries"`
+	Legend          []StateTimelineLegendOptions `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions   `tfsdk:"tooltip"`
+	Field           []FieldOptions               `tfsdk:"field"`
+	Graph           []StatusHistoryGraphOptions  `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions       `tfsdk:"overrides"`
+	Transformations []Transformations            `tfsdk:"transform"`
+}
+
+type StatusHistoryGraphOptions struct {
+	ShowValues  types.String  `tfsdk:"show_values"`
+	RowHeight   types.Float64 `tfsdk:"row_height"`
+	ColumnWidth types.Float64 `tfsdk:"column_width"`
+	FillOpacity types.Int64   `tfsdk:"fill_opacity"`
+}
+
+func (d *StatusHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_history"
+}
+
+func statusHistoryGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"show_values": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Controls whether values are rendered inside the boxes. The choices are: auto, always, never.",
+					MarkdownDescription: "Controls whether values are rendered inside the boxes. The choices are: `auto`, `always`, `never`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "always", "never"),
+					},
+				},
+				"row_height": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "Controls how much space between rows there are. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "Controls how much space between rows there are. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"column_width": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "Controls the width of the boxes. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "Controls the width of the boxes. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"fill_opacity": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The opacity of the boxes. Must be between 0 and 100 (inclusive).",
+					MarkdownDescription: "The opacity of the boxes. Must be between `0` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 100),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func (d *StatusHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Status history panel data source.",
+		MarkdownDescription: "Status history panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/status-history/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    stateTimelineLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"graph":     statusHistoryGraphBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *StatusHistoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.StatusHistory
+}
+
+func (d *StatusHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusHistoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       []string{},
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	options := grafana.StatusHistoryOptions{
+		ShowValue: d.Defaults.Graph.ShowValues,
+		RowHeight: d.Defaults.Graph.RowHeight,
+		ColWidth:  d.Defaults.Graph.ColumnWidth,
+		Legend:    legendOptions,
+		Tooltip:   tooltipOptions,
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		LineWidth:   1,
+		FillOpacity: d.Defaults.Graph.FillOpacity,
+	}
+
+	for _, graph := range data.Graph {
+		if !graph.ShowValues.IsNull() {
+			options.ShowValue = graph.ShowValues.ValueString()
+		}
+
+		if !graph.RowHeight.IsNull() {
+			options.RowHeight = graph.RowHeight.ValueFloat64()
+		}
+
+		if !graph.ColumnWidth.IsNull() {
+			options.ColWidth = graph.ColumnWidth.ValueFloat64()
+		}
+
+		if !graph.FillOpacity.IsNull() {
+			fieldConfig.Custom.FillOpacity = int(graph.FillOpacity.ValueInt64())
+		}
+	}
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.StatusHistoryType,
+			Title:           data.Title.ValueString(),
+			Type:            "status-history",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		StatusHistoryPanel: &grafana.StatusHistoryPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStatusHistoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatusHistoryDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "json", testAccStatusHistoryDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatusHistoryDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "json", testAccStatusHistoryDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatusHistoryDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_status_history.test", "json", testAccStatusHistoryDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccStatusHistoryDataSourceConfig = `
+data "gdashboard_status_history" "test" {
+  title       = "Test"
+  description = "Status history description"
+
+  legend {
+    display_mode = "list"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit = "percent"
+
+    color {
+      mode = "thresholds"
+    }
+
+    thresholds {
+      mode = "absolute"
+
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "orange"
+        value = 70
+      }
+
+      step {
+        color = "red"
+        value = 90
+      }
+    }
+
+    mappings {
+      range {
+        from         = 90
+        to           = 100
+        display_text = "Critical"
+      }
+    }
+  }
+
+  graph {
+    show_values  = "always"
+    row_height   = 0.8
+    column_width = 0.7
+    fill_opacity = 60
+  }
+
+  overrides {
+    by_name {
+      name = "host-1"
+
+      field {
+        unit = "percentunit"
+      }
+    }
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "100 - avg by (instance) (rate(node_cpu_seconds_total{mode='idle'}[$__rate_interval])) * 100"
+      ref_id = "Prometheus_Query"
+    }
+
+    testdata {
+      uid    = "testdata"
+      labels = "instance=host-$seriesIndex"
+      ref_id = "Demo"
+
+      predictable_pulse {
+        time_step = 30
+        on_value  = 95
+        off_value = 10
+      }
+    }
+  }
+}
+`
+
+const testAccStatusHistoryDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Status history description",
+  "transparent": false,
+  "type": "status-history",
+  "targets": [
+    {
+      "refId": "Prometheus_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "100 - avg by (instance) (rate(node_cpu_seconds_total{mode='idle'}[$__rate_interval])) * 100"
+    },
+    {
+      "refId": "Demo",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "testdata",
+        "name": "",
+        "type": "grafana-testdata-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "scenarioId": "predictable_pulse",
+      "labels": "instance=host-$seriesIndex",
+      "pulseWave": {
+        "timeStep": 30,
+        "onCount": 3,
+        "onValue": 95,
+        "offCount": 3,
+        "offValue": 10
+      }
+    }
+  ],
+  "options": {
+    "showValue": "always",
+    "rowHeight": 0.8,
+    "colWidth": 0.7,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "thresholds",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          },
+          {
+            "color": "orange",
+            "value": 70
+          },
+          {
+            "color": "red",
+            "value": 90
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 60,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      },
+      "mappings": [
+        {
+          "type": "range",
+          "options": {
+            "from": 90,
+            "result": {
+              "text": "Critical",
+              "index": 0
+            },
+            "to": 90
+          }
+        }
+      ]
+    },
+    "overrides": [
+      {
+        "matcher": {
+          "id": "byName",
+          "options": "host-1"
+        },
+        "properties": [
+          {
+            "id": "unit",
+            "value": "percentunit"
+          }
+        ]
+      }
+    ]
+  }
+}`
+
+const testAccStatusHistoryDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    status_history {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "percent"
+      }
+
+      graph {
+        show_values  = "never"
+        row_height   = 1
+        column_width = 1
+        fill_opacity = 100
+      }
+    }
+  }
+}
+
+data "gdashboard_status_history" "test" {
+  title = "Test"
+}
+`
+
+const testAccStatusHistoryDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "status-history",
+  "options": {
+    "showValue": "never",
+    "rowHeight": 1,
+    "colWidth": 1,
+    "legend": {
+      "calcs": [],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 100,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStatusHistoryDataSourceProviderDefaultsConfig = `
+data "gdashboard_status_history" "test" {
+  title = "Test"
+}
+`
+
+const testAccStatusHistoryDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "status-history",
+  "options": {
+    "showValue": "auto",
+    "rowHeight": 0.9,
+    "colWidth": 0.9,
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 70,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
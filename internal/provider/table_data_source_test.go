@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,6 +13,32 @@ func TestAccTableDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Read testing
+			{
+				Config:      testAccTableDataSourceSQLExpressionUnknownReferenceConfig,
+				ExpectError: regexp.MustCompile("The SQL expression references the table \"Orders\", but there is no query with"),
+			},
+			{
+				Config:      testAccTableDataSourceSQLExpressionCommaJoinUnknownReferenceConfig,
+				ExpectError: regexp.MustCompile("The SQL expression references the table \"C\", but there is no query with"),
+			},
+			{
+				Config:      testAccTableDataSourceSQLExpressionUnknownUIDConfig,
+				ExpectError: regexp.MustCompile("The SQL expression references the table \"Orders\", but there is no query with"),
+			},
+			{
+				Config: testAccTableDataSourceSQLExpressionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceSQLExpressionConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTableDataSourceSQLExpressionFunctionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceSQLExpressionFunctionsConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccTableDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -19,6 +46,27 @@ func TestAccTableDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccTableDataSourceJaegerTempoConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceJaegerTempoConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTableDataSourceSQLConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceSQLConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTableDataSourceInfluxDBConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_table.test", "json", testAccTableDataSourceInfluxDBConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccTableDataSourceProviderCustomDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -37,76 +85,96 @@ func TestAccTableDataSource(t *testing.T) {
 	})
 }
 
-const testAccTableDataSourceConfig = `
+const testAccTableDataSourceSQLExpressionUnknownReferenceConfig = `
 data "gdashboard_table" "test" {
-  title       = "Test"
-  description = "Table description"
+  title = "Test"
 
-  graph {
-	show_header = false
+  queries {
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT region, amount FROM orders"
+      ref_id  = "A"
+    }
 
-	column {
-	  align 	 = "right"
-	  filterable = true
-	  width 	 = 30
-      min_width  = 50
-	}
+    expression {
+      sql {
+        query = "SELECT region, sum(amount) FROM A JOIN Orders ON A.region = Orders.region GROUP BY region"
+      }
+    }
+  }
+}
+`
 
-	cell {
-	  inspectable  = true
-	  display_mode = "basic" 
-	}
+const testAccTableDataSourceSQLExpressionCommaJoinUnknownReferenceConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
 
-	footer {
-	  pagination   = true
-	  fields 	   = ["a", "b"]
-      calculations = ["min", "max"]
-	}
-  }
+  queries {
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT region, amount FROM orders"
+      ref_id  = "A"
+    }
 
-  field {
-    unit     = "bytes"
-    decimals = 1
-    min      = 0
-    max      = 10000
-    
-    color { 
-      mode        = "palette-classic"
-      fixed_color = "red"
-      series_by   = "first"
+    expression {
+      sql {
+        query = "SELECT A.region, C.amount FROM A, C WHERE A.region = C.region"
+      }
     }
+  }
+}
+`
 
-	thresholds {
-	  mode = "percentage"
-		
- 	  step {
-		color = "green"
-	  }
+const testAccTableDataSourceSQLExpressionUnknownUIDConfig = `
+resource "terraform_data" "warehouse" {
+  input = "warehouse"
+}
 
-	  step {
-		color = "orange"
-		value = 65
-	  }
+data "gdashboard_table" "test" {
+  title = "Test"
 
-	  step {
-		color = "red"
-		value = 90
-	  }
-	}
+  queries {
+    postgres {
+      uid     = terraform_data.warehouse.output
+      raw_sql = "SELECT region, amount FROM orders"
+      ref_id  = "A"
+    }
+
+    expression {
+      sql {
+        query = "SELECT region, sum(amount) FROM A JOIN Orders ON A.region = Orders.region GROUP BY region"
+      }
+    }
   }
+}
+`
+
+const testAccTableDataSourceSQLExpressionConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
 
   queries {
-    prometheus {
-      uid     = "prometheus"
-      expr    = "up{container_name='container'}"
-      instant = true
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT region, sum(amount) AS revenue FROM orders WHERE $__timeFilter(created_at) GROUP BY region"
+      ref_id  = "Revenue"
+    }
+
+    expression {
+      ref_id = "Top_Regions"
+
+      sql {
+        query = <<-EOT
+          WITH ranked AS (SELECT region, revenue FROM Revenue WHERE region <> 'FROM Unknown')
+          SELECT * FROM ranked ORDER BY revenue DESC LIMIT 5
+        EOT
+      }
     }
   }
-	
 }
 `
 
-const testAccTableDataSourceConfigExpectedJson = `{
+const testAccTableDataSourceSQLExpressionConfigExpectedJson = `{
   "editable": false,
   "error": false,
   "gridPos": {},
@@ -114,18 +182,17 @@ const testAccTableDataSourceConfigExpectedJson = `{
   "isNew": true,
   "span": 12,
   "title": "Test",
-  "description": "Table description",
   "transparent": false,
   "type": "table",
   "targets": [
     {
-      "refId": "",
+      "refId": "Revenue",
       "datasource": {
         "id": 0,
         "orgId": 0,
-        "uid": "prometheus",
+        "uid": "warehouse",
         "name": "",
-        "type": "prometheus",
+        "type": "grafana-postgresql-datasource",
         "typeLogoUrl": "",
         "access": "",
         "url": "",
@@ -133,50 +200,51 @@ const testAccTableDataSourceConfigExpectedJson = `{
         "jsonData": null,
         "secureJsonData": null
       },
-      "expr": "up{container_name='container'}",
-      "instant": true
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT region, sum(amount) AS revenue FROM orders WHERE $__timeFilter(created_at) GROUP BY region",
+      "editorMode": "code"
+    },
+    {
+      "refId": "Top_Regions",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "__expr__",
+        "name": "Expression",
+        "type": "__expr__",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "type": "sql",
+      "expression": "WITH ranked AS (SELECT region, revenue FROM Revenue WHERE region \u003c\u003e 'FROM Unknown')\nSELECT * FROM ranked ORDER BY revenue DESC LIMIT 5\n"
     }
   ],
   "options": {
-    "showHeader": false,
+    "showHeader": true,
     "footer": {
-      "show": true,
-      "enablePagination": true,
-      "fields": [
-        "a",
-        "b"
-      ],
-      "reducer": [
-        "a",
-        "b"
-      ]
+      "show": false,
+      "enablePagination": false
     }
   },
   "fieldConfig": {
     "defaults": {
-      "unit": "bytes",
-      "decimals": 1,
-      "min": 0,
-      "max": 10000,
+      "unit": "",
       "color": {
         "mode": "palette-classic",
-        "fixedColor": "red",
-        "seriesBy": "first"
+        "fixedColor": "green",
+        "seriesBy": "last"
       },
       "thresholds": {
-        "mode": "percentage",
+        "mode": "absolute",
         "steps": [
           {
             "color": "green",
             "value": null
-          },
-          {
-            "color": "orange",
-            "value": 65
-          },
-          {
-            "color": "red",
-            "value": 90
           }
         ]
       },
@@ -208,13 +276,750 @@ const testAccTableDataSourceConfigExpectedJson = `{
         },
         "thresholdsStyle": {
           "mode": ""
-        },
-        "align": "right",
-        "displayMode": "basic",
-        "inspect": true,
-        "filterable": true,
-        "width": 30,
-        "minWidth": 50
+        }
+      }
+    }
+  }
+}`
+
+const testAccTableDataSourceSQLExpressionFunctionsConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
+
+  queries {
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT time, region, amount FROM orders"
+      ref_id  = "A"
+    }
+
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT time, region, target FROM targets"
+      ref_id  = "B"
+    }
+
+    expression {
+      ref_id = "Hourly"
+
+      sql {
+        query = "SELECT EXTRACT(HOUR FROM A.time) AS hour, TRIM(LEADING ' ' FROM A.region) AS region, A.amount / B.target AS ratio /* FROM Orders */ FROM A, B WHERE A.time = B.time"
+      }
+    }
+  }
+}
+`
+
+const testAccTableDataSourceSQLExpressionFunctionsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "table",
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "warehouse",
+        "name": "",
+        "type": "grafana-postgresql-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT time, region, amount FROM orders",
+      "editorMode": "code"
+    },
+    {
+      "refId": "B",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "warehouse",
+        "name": "",
+        "type": "grafana-postgresql-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT time, region, target FROM targets",
+      "editorMode": "code"
+    },
+    {
+      "refId": "Hourly",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "__expr__",
+        "name": "Expression",
+        "type": "__expr__",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "type": "sql",
+      "expression": "SELECT EXTRACT(HOUR FROM A.time) AS hour, TRIM(LEADING ' ' FROM A.region) AS region, A.amount / B.target AS ratio /* FROM Orders */ FROM A, B WHERE A.time = B.time"
+    }
+  ],
+  "options": {
+    "showHeader": true,
+    "footer": {
+      "show": false,
+      "enablePagination": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccTableDataSourceConfig = `
+data "gdashboard_table" "test" {
+  title       = "Test"
+  description = "Table description"
+
+  graph {
+	show_header = false
+
+	column {
+	  align 	 = "right"
+	  filterable = true
+	  width 	 = 30
+      min_width  = 50
+	}
+
+	cell {
+	  inspectable  = true
+	  display_mode = "basic" 
+	}
+
+	footer {
+	  pagination   = true
+	  fields 	   = ["a", "b"]
+      calculations = ["min", "max"]
+	}
+  }
+
+  field {
+    unit     = "bytes"
+    decimals = 1
+    min      = 0
+    max      = 10000
+    
+    color { 
+      mode        = "palette-classic"
+      fixed_color = "red"
+      series_by   = "first"
+    }
+
+	thresholds {
+	  mode = "percentage"
+		
+ 	  step {
+		color = "green"
+	  }
+
+	  step {
+		color = "orange"
+		value = 65
+	  }
+
+	  step {
+		color = "red"
+		value = 90
+	  }
+	}
+  }
+
+  queries {
+    prometheus {
+      uid     = "prometheus"
+      expr    = "up{container_name='container'}"
+      instant = true
+    }
+  }
+	
+}
+`
+
+const testAccTableDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Table description",
+  "transparent": false,
+  "type": "table",
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "up{container_name='container'}",
+      "instant": true
+    }
+  ],
+  "options": {
+    "showHeader": false,
+    "footer": {
+      "show": true,
+      "enablePagination": true,
+      "fields": [
+        "a",
+        "b"
+      ],
+      "reducer": [
+        "a",
+        "b"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "bytes",
+      "decimals": 1,
+      "min": 0,
+      "max": 10000,
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "red",
+        "seriesBy": "first"
+      },
+      "thresholds": {
+        "mode": "percentage",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          },
+          {
+            "color": "orange",
+            "value": 65
+          },
+          {
+            "color": "red",
+            "value": 90
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        },
+        "align": "right",
+        "displayMode": "basic",
+        "inspect": true,
+        "filterable": true,
+        "width": 30,
+        "minWidth": 50
+      }
+    }
+  }
+}`
+
+const testAccTableDataSourceJaegerTempoConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
+
+  queries {
+    jaeger {
+      uid    = "jaeger"
+      ref_id = "Traces"
+
+      search {
+        service      = "backend"
+        operation    = "GET /api/orders"
+        tags         = "http.status_code=500"
+        min_duration = "100ms"
+        max_duration = "5s"
+        limit        = 20
+      }
+    }
+
+    tempo {
+      uid = "tempo"
+
+      search {}
+    }
+  }
+}
+`
+
+const testAccTableDataSourceJaegerTempoConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "table",
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "tempo",
+        "name": "",
+        "type": "tempo",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "traceql",
+      "query": "{}",
+      "tableType": "traces"
+    },
+    {
+      "refId": "Traces",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "jaeger",
+        "name": "",
+        "type": "jaeger",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "search",
+      "limit": 20,
+      "service": "backend",
+      "operation": "GET /api/orders",
+      "tags": "http.status_code=500",
+      "minDuration": "100ms",
+      "maxDuration": "5s"
+    }
+  ],
+  "options": {
+    "showHeader": true,
+    "footer": {
+      "show": false,
+      "enablePagination": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccTableDataSourceSQLConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
+
+  queries {
+    postgres {
+      uid     = "warehouse"
+      raw_sql = "SELECT region, sum(amount) AS revenue FROM orders WHERE $__timeFilter(created_at) GROUP BY region"
+      ref_id  = "Revenue"
+    }
+
+    mysql {
+      uid         = "shop"
+      raw_sql     = "SELECT $__timeGroupAlias(created_at, $__interval), count(*) AS value FROM orders GROUP BY 1 ORDER BY 1"
+      format      = "time_series"
+      editor_mode = "builder"
+      hide        = true
+    }
+
+    mssql {
+      uid     = "erp"
+      raw_sql = "SELECT TOP 10 name, stock FROM products ORDER BY stock"
+    }
+  }
+}
+`
+
+const testAccTableDataSourceSQLConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "table",
+  "targets": [
+    {
+      "refId": "Revenue",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "warehouse",
+        "name": "",
+        "type": "grafana-postgresql-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT region, sum(amount) AS revenue FROM orders WHERE $__timeFilter(created_at) GROUP BY region",
+      "editorMode": "code"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "shop",
+        "name": "",
+        "type": "mysql",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "format": "time_series",
+      "rawQuery": true,
+      "rawSql": "SELECT $__timeGroupAlias(created_at, $__interval), count(*) AS value FROM orders GROUP BY 1 ORDER BY 1",
+      "editorMode": "builder"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "erp",
+        "name": "",
+        "type": "mssql",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT TOP 10 name, stock FROM products ORDER BY stock",
+      "editorMode": "code"
+    }
+  ],
+  "options": {
+    "showHeader": true,
+    "footer": {
+      "show": false,
+      "enablePagination": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccTableDataSourceInfluxDBConfig = `
+data "gdashboard_table" "test" {
+  title = "Test"
+
+  queries {
+    influxdb {
+      uid = "influxdb-sql"
+
+      sql {
+        query  = "SELECT host, max(usage) FROM cpu WHERE $__timeFilter(time) GROUP BY host"
+        format = "table"
+      }
+    }
+  }
+}
+`
+
+const testAccTableDataSourceInfluxDBConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "table",
+  "targets": [
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "influxdb-sql",
+        "name": "",
+        "type": "influxdb",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "format": "table",
+      "rawQuery": true,
+      "rawSql": "SELECT host, max(usage) FROM cpu WHERE $__timeFilter(time) GROUP BY host"
+    }
+  ],
+  "options": {
+    "showHeader": true,
+    "footer": {
+      "show": false,
+      "enablePagination": false
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
       }
     }
   }
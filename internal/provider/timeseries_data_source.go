@@ -365,33 +365,7 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	fieldConfig.Custom.ScaleDistribution.Log = d.Defaults.Axis.Scale.Log
 	fieldConfig.Custom.ThresholdsStyle.Mode = d.Defaults.Field.Thresholds.ShowAs
 
-	for _, axis := range data.Axis {
-		if !axis.Label.IsNull() {
-			fieldConfig.Custom.AxisLabel = axis.Label.ValueString()
-		}
-
-		if !axis.Placement.IsNull() {
-			fieldConfig.Custom.AxisPlacement = axis.Placement.ValueString()
-		}
-
-		if !axis.SoftMin.IsNull() {
-			fieldConfig.Custom.AxisSoftMin = axis.SoftMin.ValueFloat64Pointer()
-		}
-
-		if !axis.SoftMax.IsNull() {
-			fieldConfig.Custom.AxisSoftMax = axis.SoftMax.ValueFloat64Pointer()
-		}
-
-		for _, scale := range axis.Scale {
-			if !scale.Type.IsNull() {
-				fieldConfig.Custom.ScaleDistribution.Type = scale.Type.ValueString()
-			}
-
-			if !scale.Log.IsNull() {
-				fieldConfig.Custom.ScaleDistribution.Log = int(scale.Log.ValueInt64())
-			}
-		}
-	}
+	updateAxis(&fieldConfig.Custom, data.Axis)
 
 	for _, graph := range data.Graph {
 		if !graph.DrawStyle.IsNull() {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,6 +13,22 @@ func TestAccTimeseriesDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Read testing
+			{
+				Config:      testAccTimeseriesDataSourceElasticsearchMetricConflictConfig,
+				ExpectError: regexp.MustCompile("Attribute \"queries\\[0]\\.elasticsearch\\[0]\\.metric\\[0]\\.avg\" cannot be specified when"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceElasticsearchEmptyMetricConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceElasticsearchRawDataBucketConfig,
+				ExpectError: regexp.MustCompile("Bucket aggregations cannot be used with the raw_data metric"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceGraphiteRefIDConfig,
+				ExpectError: regexp.MustCompile("Graphite references only support the IDs from A to Z"),
+			},
 			{
 				Config: testAccTimeseriesDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -19,6 +36,27 @@ func TestAccTimeseriesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccTimeseriesDataSourceTempoConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceTempoConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTimeseriesDataSourceGraphiteConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceGraphiteConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTimeseriesDataSourceElasticsearchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceElasticsearchConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccTimeseriesDataSourceProviderCustomDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -37,6 +75,76 @@ func TestAccTimeseriesDataSource(t *testing.T) {
 	})
 }
 
+const testAccTimeseriesDataSourceElasticsearchMetricConflictConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    elasticsearch {
+      uid = "elasticsearch"
+
+      metric {
+        count {}
+
+        avg {
+          field = "latency"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceElasticsearchEmptyMetricConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    elasticsearch {
+      uid = "elasticsearch"
+
+      metric {}
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceElasticsearchRawDataBucketConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    elasticsearch {
+      uid = "elasticsearch"
+
+      metric {
+        raw_data {}
+      }
+
+      bucket_aggregation {
+        terms {
+          field = "host"
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceGraphiteRefIDConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    graphite {
+      uid    = "graphite"
+      target = "sumSeries(servers.*.requests.errors)"
+      ref_id = "Errors"
+    }
+  }
+}
+`
+
 const testAccTimeseriesDataSourceConfig = `
 data "gdashboard_timeseries" "test" {
   title       = "Test"
@@ -244,6 +352,514 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
   }
 }`
 
+const testAccTimeseriesDataSourceTempoConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    tempo {
+      uid    = "tempo"
+      ref_id = "Span_Errors"
+
+      metrics {
+        query = "{ status = error } | rate() by (resource.service.name)"
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceTempoConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "targets": [
+    {
+      "refId": "Span_Errors",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "tempo",
+        "name": "",
+        "type": "tempo",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "traceql",
+      "query": "{ status = error } | rate() by (resource.service.name)",
+      "metricsQueryType": "range"
+    }
+  ],
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccTimeseriesDataSourceGraphiteConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    graphite {
+      uid    = "graphite"
+      target = "sumSeries(servers.*.requests.errors)"
+      hide   = true
+      ref_id = "A"
+    }
+
+    graphite {
+      uid         = "graphite"
+      target      = "sumSeries(servers.*.requests.total)"
+      hide        = true
+      text_editor = true
+      ref_id      = "B"
+    }
+
+    graphite {
+      uid    = "graphite"
+      target = "alias(asPercent(#A, #B), 'Error rate')"
+      ref_id = "C"
+    }
+
+    graphite {
+      uid    = "graphite"
+      target = "alias(asPercent(#A, sumSeries(#A, #B)), 'Error share')"
+      ref_id = "D"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceGraphiteConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "graphite",
+        "name": "",
+        "type": "graphite",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "target": "sumSeries(servers.*.requests.errors)",
+      "refCount": 2
+    },
+    {
+      "refId": "B",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "graphite",
+        "name": "",
+        "type": "graphite",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "target": "sumSeries(servers.*.requests.total)",
+      "refCount": 2,
+      "textEditor": true
+    },
+    {
+      "refId": "C",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "graphite",
+        "name": "",
+        "type": "graphite",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "target": "alias(asPercent(#A, #B), 'Error rate')",
+      "targetFull": "alias(asPercent(sumSeries(servers.*.requests.errors), sumSeries(servers.*.requests.total)), 'Error rate')"
+    },
+    {
+      "refId": "D",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "graphite",
+        "name": "",
+        "type": "graphite",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "target": "alias(asPercent(#A, sumSeries(#A, #B)), 'Error share')",
+      "targetFull": "alias(asPercent(sumSeries(servers.*.requests.errors), sumSeries(sumSeries(servers.*.requests.errors), sumSeries(servers.*.requests.total))), 'Error share')"
+    }
+  ],
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccTimeseriesDataSourceElasticsearchConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    elasticsearch {
+      uid        = "elasticsearch"
+      query      = "service:backend AND status:[500 TO 599]"
+      alias      = "{{term host}} {{metric}}"
+      time_field = "@timestamp"
+      ref_id     = "ES_Query"
+
+      metric {
+        avg {
+          field = "latency"
+        }
+      }
+
+      metric {
+        hide = true
+
+        percentiles {
+          field    = "latency"
+          percents = [50, 99.9]
+        }
+      }
+
+      bucket_aggregation {
+        terms {
+          field    = "host"
+          size     = 5
+          order    = "asc"
+          order_by = "1"
+        }
+      }
+
+      bucket_aggregation {
+        date_histogram {
+          interval      = "1m"
+          min_doc_count = 0
+          trim_edges    = 1
+        }
+      }
+    }
+
+    opensearch {
+      uid = "opensearch"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceElasticsearchConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "targets": [
+    {
+      "refId": "ES_Query",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "elasticsearch",
+        "name": "",
+        "type": "elasticsearch",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "query": "service:backend AND status:[500 TO 599]",
+      "alias": "{{term host}} {{metric}}",
+      "timeField": "@timestamp",
+      "metrics": [
+        {
+          "id": "1",
+          "type": "avg",
+          "field": "latency"
+        },
+        {
+          "id": "2",
+          "type": "percentiles",
+          "field": "latency",
+          "hide": true,
+          "settings": {
+            "percents": [
+              "50",
+              "99.9"
+            ]
+          }
+        }
+      ],
+      "bucketAggs": [
+        {
+          "id": "3",
+          "type": "terms",
+          "field": "host",
+          "settings": {
+            "size": "5",
+            "order": "asc",
+            "orderBy": "1"
+          }
+        },
+        {
+          "id": "4",
+          "type": "date_histogram",
+          "settings": {
+            "interval": "1m",
+            "min_doc_count": "0",
+            "trimEdges": "1"
+          }
+        }
+      ]
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "opensearch",
+        "name": "",
+        "type": "grafana-opensearch-datasource",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "lucene",
+      "metrics": [
+        {
+          "id": "1",
+          "type": "count"
+        }
+      ],
+      "bucketAggs": [
+        {
+          "id": "2",
+          "type": "date_histogram",
+          "settings": {
+            "interval": "auto"
+          }
+        }
+      ]
+    }
+  ],
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
 const testAccTimeseriesDataSourceProviderCustomDefaultsConfig = `
 provider "gdashboard" {
   defaults {
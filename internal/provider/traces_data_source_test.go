@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTracesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTracesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_traces.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_traces.test", "json", testAccTracesDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTracesDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_traces.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_traces.test", "json", testAccTracesDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccTracesDataSourceTempoMissingQueryConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+			{
+				Config:      testAccTracesDataSourceJaegerMissingQueryConfig,
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+		},
+	})
+}
+
+const testAccTracesDataSourceTempoMissingQueryConfig = `
+data "gdashboard_traces" "test" {
+  title = "Test"
+
+  queries {
+    tempo {
+      uid = "tempo"
+    }
+  }
+}
+`
+
+const testAccTracesDataSourceJaegerMissingQueryConfig = `
+data "gdashboard_traces" "test" {
+  title = "Test"
+
+  queries {
+    jaeger {
+      uid = "jaeger"
+    }
+  }
+}
+`
+
+const testAccTracesDataSourceConfig = `
+data "gdashboard_traces" "test" {
+  title       = "Test"
+  description = "Traces description"
+
+  queries {
+    min_interval = "1m"
+
+    tempo {
+      uid    = "tempo"
+      ref_id = "Search"
+
+      search {
+        query      = "{ resource.service.name = \"backend\" && status = error }"
+        limit      = 50
+        span_limit = 5
+        table_type = "spans"
+      }
+    }
+
+    tempo {
+      uid = "tempo"
+
+      trace {
+        trace_id = "2f3e0cee77ae5dc9c17ade3689eb2e54"
+      }
+    }
+
+    jaeger {
+      uid = "jaeger"
+
+      trace {
+        trace_id = "6b2c2b1f1c2a3e4d"
+      }
+    }
+  }
+}
+`
+
+const testAccTracesDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "Traces description",
+  "transparent": false,
+  "type": "traces",
+  "interval": "1m",
+  "targets": [
+    {
+      "refId": "Search",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "tempo",
+        "name": "",
+        "type": "tempo",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "traceql",
+      "query": "{ resource.service.name = \"backend\" \u0026\u0026 status = error }",
+      "limit": 50,
+      "spss": 5,
+      "tableType": "spans"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "tempo",
+        "name": "",
+        "type": "tempo",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "queryType": "traceql",
+      "query": "2f3e0cee77ae5dc9c17ade3689eb2e54"
+    },
+    {
+      "refId": "",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "jaeger",
+        "name": "",
+        "type": "jaeger",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "query": "6b2c2b1f1c2a3e4d"
+    }
+  ]
+}`
+
+const testAccTracesDataSourceProviderDefaultsConfig = `
+data "gdashboard_traces" "test" {
+  title = "Test"
+}
+`
+
+const testAccTracesDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "traces"
+}`
@@ -1,16 +1,23 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"hash/crc32"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -144,6 +151,19 @@ type ScaleDefaults struct {
 	Log  int
 }
 
+func NewAxisDefaults() AxisDefaults {
+	return AxisDefaults{
+		Label:     "",
+		Placement: "auto",
+		SoftMin:   nil,
+		SoftMax:   nil,
+		Scale: ScaleDefaults{
+			Type: "linear",
+			Log:  0,
+		},
+	}
+}
+
 // Terraform projections
 
 type AxisOptions struct {
@@ -260,10 +280,24 @@ type ByQueryIDOverrideOptions struct {
 }
 
 type Query struct {
-	MinInterval types.String       `tfsdk:"min_interval"`
-	Prometheus  []PrometheusTarget `tfsdk:"prometheus"`
-	CloudWatch  []CloudWatchTarget `tfsdk:"cloudwatch"`
-	Expression []ExpressionTarget `tfsdk:"expression"`
+	MinInterval     types.String            `tfsdk:"min_interval"`
+	Prometheus      []PrometheusTarget      `tfsdk:"prometheus"`
+	CloudWatch      []CloudWatchTarget      `tfsdk:"cloudwatch"`
+	Expression      []ExpressionTarget      `tfsdk:"expression"`
+	Pyroscope       []PyroscopeTarget       `tfsdk:"pyroscope"`
+	Loki            []LokiTarget            `tfsdk:"loki"`
+	Elasticsearch   []ElasticsearchTarget   `tfsdk:"elasticsearch"`
+	OpenSearch      []ElasticsearchTarget   `tfsdk:"opensearch"`
+	InfluxDB        []InfluxDBTarget        `tfsdk:"influxdb"`
+	Postgres        []SQLTarget             `tfsdk:"postgres"`
+	MySQL           []SQLTarget             `tfsdk:"mysql"`
+	MSSQL           []SQLTarget             `tfsdk:"mssql"`
+	Graphite        []GraphiteTarget        `tfsdk:"graphite"`
+	Tempo           []TempoTarget           `tfsdk:"tempo"`
+	Jaeger          []JaegerTarget          `tfsdk:"jaeger"`
+	AzureMonitor    []AzureMonitorTarget    `tfsdk:"azure_monitor"`
+	CloudMonitoring []CloudMonitoringTarget `tfsdk:"google_cloud_monitoring"`
+	TestData        []TestDataTarget        `tfsdk:"testdata"`
 }
 
 type PrometheusTarget struct {
@@ -317,12 +351,341 @@ type CloudWatchDimension struct {
 	Value types.String `tfsdk:"value"`
 }
 
+type LokiTarget struct {
+	UID          types.String `tfsdk:"uid"`
+	Hide         types.Bool   `tfsdk:"hide"`
+	Expr         types.String `tfsdk:"expr"`
+	QueryType    types.String `tfsdk:"query_type"`
+	LegendFormat types.String `tfsdk:"legend_format"`
+	MaxLines     types.Int64  `tfsdk:"max_lines"`
+	Resolution   types.Int64  `tfsdk:"resolution"`
+	Step         types.String `tfsdk:"step"`
+	Direction    types.String `tfsdk:"direction"`
+	RefId        types.String `tfsdk:"ref_id"`
+}
+
+type ElasticsearchTarget struct {
+	UID                types.String                     `tfsdk:"uid"`
+	Hide               types.Bool                       `tfsdk:"hide"`
+	Query              types.String                     `tfsdk:"query"`
+	Alias              types.String                     `tfsdk:"alias"`
+	TimeField          types.String                     `tfsdk:"time_field"`
+	Metrics            []ElasticsearchMetric            `tfsdk:"metric"`
+	BucketAggregations []ElasticsearchBucketAggregation `tfsdk:"bucket_aggregation"`
+	RefId              types.String                     `tfsdk:"ref_id"`
+}
+
+type ElasticsearchMetric struct {
+	Hide        types.Bool                       `tfsdk:"hide"`
+	Count       []ElasticsearchCountMetric       `tfsdk:"count"`
+	Avg         []ElasticsearchFieldMetric       `tfsdk:"avg"`
+	Sum         []ElasticsearchFieldMetric       `tfsdk:"sum"`
+	Min         []ElasticsearchFieldMetric       `tfsdk:"min"`
+	Max         []ElasticsearchFieldMetric       `tfsdk:"max"`
+	Percentiles []ElasticsearchPercentilesMetric `tfsdk:"percentiles"`
+	Cardinality []ElasticsearchFieldMetric       `tfsdk:"cardinality"`
+	RawData     []ElasticsearchRawDataMetric     `tfsdk:"raw_data"`
+	Logs        []ElasticsearchLogsMetric        `tfsdk:"logs"`
+}
+
+type ElasticsearchCountMetric struct{}
+
+type ElasticsearchFieldMetric struct {
+	Field types.String `tfsdk:"field"`
+}
+
+type ElasticsearchPercentilesMetric struct {
+	Field    types.String    `tfsdk:"field"`
+	Percents []types.Float64 `tfsdk:"percents"`
+}
+
+type ElasticsearchRawDataMetric struct {
+	Size types.Int64 `tfsdk:"size"`
+}
+
+type ElasticsearchLogsMetric struct {
+	Limit types.Int64 `tfsdk:"limit"`
+}
+
+type ElasticsearchBucketAggregation struct {
+	DateHistogram []ElasticsearchDateHistogramAggregation `tfsdk:"date_histogram"`
+	Terms         []ElasticsearchTermsAggregation         `tfsdk:"terms"`
+	Filters       []ElasticsearchFiltersAggregation       `tfsdk:"filters"`
+	Histogram     []ElasticsearchHistogramAggregation     `tfsdk:"histogram"`
+	GeohashGrid   []ElasticsearchGeohashGridAggregation   `tfsdk:"geohash_grid"`
+}
+
+type ElasticsearchDateHistogramAggregation struct {
+	Field       types.String `tfsdk:"field"`
+	Interval    types.String `tfsdk:"interval"`
+	MinDocCount types.Int64  `tfsdk:"min_doc_count"`
+	TrimEdges   types.Int64  `tfsdk:"trim_edges"`
+	Offset      types.String `tfsdk:"offset"`
+}
+
+type ElasticsearchTermsAggregation struct {
+	Field       types.String `tfsdk:"field"`
+	Size        types.Int64  `tfsdk:"size"`
+	Order       types.String `tfsdk:"order"`
+	OrderBy     types.String `tfsdk:"order_by"`
+	MinDocCount types.Int64  `tfsdk:"min_doc_count"`
+}
+
+type ElasticsearchFiltersAggregation struct {
+	Filters []ElasticsearchFilter `tfsdk:"filter"`
+}
+
+type ElasticsearchFilter struct {
+	Query types.String `tfsdk:"query"`
+	Label types.String `tfsdk:"label"`
+}
+
+type ElasticsearchHistogramAggregation struct {
+	Field       types.String  `tfsdk:"field"`
+	Interval    types.Float64 `tfsdk:"interval"`
+	MinDocCount types.Int64   `tfsdk:"min_doc_count"`
+}
+
+type ElasticsearchGeohashGridAggregation struct {
+	Field     types.String `tfsdk:"field"`
+	Precision types.Int64  `tfsdk:"precision"`
+}
+
+type InfluxDBTarget struct {
+	UID      types.String     `tfsdk:"uid"`
+	Hide     types.Bool       `tfsdk:"hide"`
+	InfluxQL []InfluxQLQuery  `tfsdk:"influxql"`
+	Flux     []FluxQuery      `tfsdk:"flux"`
+	SQL      []InfluxSQLQuery `tfsdk:"sql"`
+	RefId    types.String     `tfsdk:"ref_id"`
+}
+
+type InfluxQLQuery struct {
+	Query        types.String `tfsdk:"query"`
+	ResultFormat types.String `tfsdk:"result_format"`
+	Alias        types.String `tfsdk:"alias"`
+}
+
+type FluxQuery struct {
+	Query types.String `tfsdk:"query"`
+}
+
+type InfluxSQLQuery struct {
+	Query  types.String `tfsdk:"query"`
+	Format types.String `tfsdk:"format"`
+}
+
+type SQLTarget struct {
+	UID        types.String `tfsdk:"uid"`
+	Hide       types.Bool   `tfsdk:"hide"`
+	RawSQL     types.String `tfsdk:"raw_sql"`
+	Format     types.String `tfsdk:"format"`
+	EditorMode types.String `tfsdk:"editor_mode"`
+	RefId      types.String `tfsdk:"ref_id"`
+}
+
+type GraphiteTarget struct {
+	UID        types.String `tfsdk:"uid"`
+	Hide       types.Bool   `tfsdk:"hide"`
+	Target     types.String `tfsdk:"target"`
+	TextEditor types.Bool   `tfsdk:"text_editor"`
+	RefId      types.String `tfsdk:"ref_id"`
+}
+
+type TempoTarget struct {
+	UID          types.String             `tfsdk:"uid"`
+	Hide         types.Bool               `tfsdk:"hide"`
+	Search       []TempoSearchQuery       `tfsdk:"search"`
+	Trace        []TraceIDQuery           `tfsdk:"trace"`
+	ServiceGraph []TempoServiceGraphQuery `tfsdk:"service_graph"`
+	Metrics      []TempoMetricsQuery      `tfsdk:"metrics"`
+	RefId        types.String             `tfsdk:"ref_id"`
+}
+
+type TempoSearchQuery struct {
+	Query     types.String `tfsdk:"query"`
+	Limit     types.Int64  `tfsdk:"limit"`
+	SpanLimit types.Int64  `tfsdk:"span_limit"`
+	TableType types.String `tfsdk:"table_type"`
+}
+
+type TraceIDQuery struct {
+	TraceID types.String `tfsdk:"trace_id"`
+}
+
+type TempoServiceGraphQuery struct {
+	Filter           types.String `tfsdk:"filter"`
+	IncludeNamespace types.Bool   `tfsdk:"include_namespace"`
+}
+
+type TempoMetricsQuery struct {
+	Query types.String `tfsdk:"query"`
+	Type  types.String `tfsdk:"type"`
+}
+
+type JaegerTarget struct {
+	UID    types.String        `tfsdk:"uid"`
+	Hide   types.Bool          `tfsdk:"hide"`
+	Search []JaegerSearchQuery `tfsdk:"search"`
+	Trace  []TraceIDQuery      `tfsdk:"trace"`
+	RefId  types.String        `tfsdk:"ref_id"`
+}
+
+type JaegerSearchQuery struct {
+	Service     types.String `tfsdk:"service"`
+	Operation   types.String `tfsdk:"operation"`
+	Tags        types.String `tfsdk:"tags"`
+	MinDuration types.String `tfsdk:"min_duration"`
+	MaxDuration types.String `tfsdk:"max_duration"`
+	Limit       types.Int64  `tfsdk:"limit"`
+}
+
+type AzureMonitorTarget struct {
+	UID           types.String                     `tfsdk:"uid"`
+	Hide          types.Bool                       `tfsdk:"hide"`
+	Metrics       []AzureMonitorMetricsQuery       `tfsdk:"metrics"`
+	Logs          []AzureMonitorLogsQuery          `tfsdk:"logs"`
+	ResourceGraph []AzureMonitorResourceGraphQuery `tfsdk:"resource_graph"`
+	RefId         types.String                     `tfsdk:"ref_id"`
+}
+
+type AzureMonitorMetricsQuery struct {
+	Subscription     types.String                  `tfsdk:"subscription"`
+	ResourceGroup    types.String                  `tfsdk:"resource_group"`
+	Namespace        types.String                  `tfsdk:"namespace"`
+	ResourceName     types.String                  `tfsdk:"resource_name"`
+	Region           types.String                  `tfsdk:"region"`
+	MetricName       types.String                  `tfsdk:"metric_name"`
+	Aggregation      types.String                  `tfsdk:"aggregation"`
+	TimeGrain        types.String                  `tfsdk:"time_grain"`
+	DimensionFilters []AzureMonitorDimensionFilter `tfsdk:"dimension_filter"`
+	Top              types.Int64                   `tfsdk:"top"`
+	Alias            types.String                  `tfsdk:"alias"`
+}
+
+type AzureMonitorDimensionFilter struct {
+	Dimension types.String   `tfsdk:"dimension"`
+	Operator  types.String   `tfsdk:"operator"`
+	Values    []types.String `tfsdk:"values"`
+}
+
+type AzureMonitorLogsQuery struct {
+	Query            types.String   `tfsdk:"query"`
+	Resources        []types.String `tfsdk:"resources"`
+	ResultFormat     types.String   `tfsdk:"result_format"`
+	UseDashboardTime types.Bool     `tfsdk:"use_dashboard_time"`
+	TimeColumn       types.String   `tfsdk:"time_column"`
+}
+
+type AzureMonitorResourceGraphQuery struct {
+	Query         types.String   `tfsdk:"query"`
+	Subscriptions []types.String `tfsdk:"subscriptions"`
+}
+
+type CloudMonitoringTarget struct {
+	UID     types.String                  `tfsdk:"uid"`
+	Hide    types.Bool                    `tfsdk:"hide"`
+	Builder []CloudMonitoringBuilderQuery `tfsdk:"builder"`
+	MQL     []CloudMonitoringMQLQuery     `tfsdk:"mql"`
+	PromQL  []CloudMonitoringPromQLQuery  `tfsdk:"promql"`
+	RefId   types.String                  `tfsdk:"ref_id"`
+}
+
+type CloudMonitoringBuilderQuery struct {
+	Project         types.String            `tfsdk:"project"`
+	MetricType      types.String            `tfsdk:"metric_type"`
+	Filters         []CloudMonitoringFilter `tfsdk:"filter"`
+	Aligner         types.String            `tfsdk:"aligner"`
+	Reducer         types.String            `tfsdk:"reducer"`
+	AlignmentPeriod types.String            `tfsdk:"alignment_period"`
+	GroupBy         []types.String          `tfsdk:"group_by"`
+	Alias           types.String            `tfsdk:"alias"`
+}
+
+type CloudMonitoringFilter struct {
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type CloudMonitoringMQLQuery struct {
+	Project types.String `tfsdk:"project"`
+	Query   types.String `tfsdk:"query"`
+	Alias   types.String `tfsdk:"alias"`
+}
+
+type CloudMonitoringPromQLQuery struct {
+	Project types.String `tfsdk:"project"`
+	Expr    types.String `tfsdk:"expr"`
+	Step    types.String `tfsdk:"step"`
+}
+
+type TestDataTarget struct {
+	UID              types.String                 `tfsdk:"uid"`
+	Hide             types.Bool                   `tfsdk:"hide"`
+	Alias            types.String                 `tfsdk:"alias"`
+	Labels           types.String                 `tfsdk:"labels"`
+	RandomWalk       []TestDataRandomWalkScenario `tfsdk:"random_walk"`
+	CSVContent       []TestDataContentScenario    `tfsdk:"csv_content"`
+	CSVMetricValues  []TestDataCSVMetricValues    `tfsdk:"csv_metric_values"`
+	PredictablePulse []TestDataPredictablePulse   `tfsdk:"predictable_pulse"`
+	Logs             []TestDataLogsScenario       `tfsdk:"logs"`
+	RawFrames        []TestDataContentScenario    `tfsdk:"raw_frames"`
+	RefId            types.String                 `tfsdk:"ref_id"`
+}
+
+type TestDataRandomWalkScenario struct {
+	SeriesCount types.Int64   `tfsdk:"series_count"`
+	Seed        types.Int64   `tfsdk:"seed"`
+	StartValue  types.Float64 `tfsdk:"start_value"`
+	Min         types.Float64 `tfsdk:"min"`
+	Max         types.Float64 `tfsdk:"max"`
+	Spread      types.Float64 `tfsdk:"spread"`
+	Noise       types.Float64 `tfsdk:"noise"`
+	Drop        types.Float64 `tfsdk:"drop"`
+}
+
+type TestDataContentScenario struct {
+	Content types.String `tfsdk:"content"`
+}
+
+type TestDataCSVMetricValues struct {
+	Values []types.Float64 `tfsdk:"values"`
+}
+
+type TestDataPredictablePulse struct {
+	TimeStep types.Int64   `tfsdk:"time_step"`
+	OnCount  types.Int64   `tfsdk:"on_count"`
+	OnValue  types.Float64 `tfsdk:"on_value"`
+	OffCount types.Int64   `tfsdk:"off_count"`
+	OffValue types.Float64 `tfsdk:"off_value"`
+}
+
+type TestDataLogsScenario struct {
+	Lines       types.Int64 `tfsdk:"lines"`
+	LevelColumn types.Bool  `tfsdk:"level_column"`
+}
+
+type PyroscopeTarget struct {
+	UID           types.String   `tfsdk:"uid"`
+	Hide          types.Bool     `tfsdk:"hide"`
+	ProfileTypeID types.String   `tfsdk:"profile_type_id"`
+	LabelSelector types.String   `tfsdk:"label_selector"`
+	GroupBy       []types.String `tfsdk:"group_by"`
+	MaxNodes      types.Int64    `tfsdk:"max_nodes"`
+	QueryType     types.String   `tfsdk:"query_type"`
+	RefId         types.String   `tfsdk:"ref_id"`
+}
+
 type ExpressionTarget struct {
-	RefId    types.String               `tfsdk:"ref_id"`
-	Hide     types.Bool                 `tfsdk:"hide"`
-	Math     []ExpressionMathTarget     `tfsdk:"math"`
-	Reduce   []ExpressionReduceTarget   `tfsdk:"reduce"`
-	Resample []ExpressionResampleTarget `tfsdk:"resample"`
+	RefId             types.String                        `tfsdk:"ref_id"`
+	Hide              types.Bool                          `tfsdk:"hide"`
+	Math              []ExpressionMathTarget              `tfsdk:"math"`
+	Reduce            []ExpressionReduceTarget            `tfsdk:"reduce"`
+	Resample          []ExpressionResampleTarget          `tfsdk:"resample"`
+	Threshold         []ExpressionThresholdTarget         `tfsdk:"threshold"`
+	ClassicConditions []ExpressionClassicConditionsTarget `tfsdk:"classic_conditions"`
+	SQL               []ExpressionSQLTarget               `tfsdk:"sql"`
 }
 
 type ExpressionMathTarget struct {
@@ -343,41 +706,39 @@ type ExpressionResampleTarget struct {
 	Upsample   types.String `tfsdk:"upsample"`
 }
 
+type ExpressionThresholdTarget struct {
+	Input      types.String                    `tfsdk:"input"`
+	Evaluator  types.String                    `tfsdk:"evaluator"`
+	Params     []types.Float64                 `tfsdk:"params"`
+	Hysteresis []ExpressionThresholdHysteresis `tfsdk:"hysteresis"`
+}
+
+type ExpressionThresholdHysteresis struct {
+	Params []types.Float64 `tfsdk:"params"`
+}
+
+type ExpressionClassicConditionsTarget struct {
+	Conditions []ExpressionClassicCondition `tfsdk:"condition"`
+}
+
+type ExpressionClassicCondition struct {
+	Operator  types.String    `tfsdk:"operator"`
+	Query     types.String    `tfsdk:"query"`
+	Reducer   types.String    `tfsdk:"reducer"`
+	Evaluator types.String    `tfsdk:"evaluator"`
+	Params    []types.Float64 `tfsdk:"params"`
+}
+
+type ExpressionSQLTarget struct {
+	Query types.String `tfsdk:"query"`
+}
+
 func axisBlock() schema.Block {
 	return schema.ListNestedBlock{
 		Description: "Axis display options.",
 		NestedObject: schema.NestedBlockObject{
 			Blocks: map[string]schema.Block{
-				"scale": schema.ListNestedBlock{
-					Description: "Can be used to configure the scale of the y-axis.",
-					MarkdownDescription: "Can be used to configure the scale of the y-axis. " +
-						"Another way visualize series that differ by orders of magnitude is to use a logarithmic scales. " +
-						"This is really useful for data usage or latency measurements. " +
-						"The goal here is to avoid one series dominating and delegating all the others to the bottom of the graph.",
-					NestedObject: schema.NestedBlockObject{
-						Attributes: map[string]schema.Attribute{
-							"type": schema.StringAttribute{
-								Required:            true,
-								Description:         "The type of the scale. The choices are: linear, log.",
-								MarkdownDescription: "The type of the scale. The choices are: `linear`, `log`.",
-								Validators: []validator.String{
-									stringvalidator.OneOf("linear", "log"),
-								},
-							},
-							"log": schema.Int64Attribute{
-								Optional:            true,
-								Description:         "The power of the logarithmic scale. The choices are: 2, 10.",
-								MarkdownDescription: "The power of the logarithmic scale. The choices are: `2`, `10`.",
-								Validators: []validator.Int64{
-									int64validator.OneOf(2, 10),
-								},
-							},
-						},
-					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(1),
-					},
-				},
+				"scale": scaleBlock(),
 			},
 			Attributes: map[string]schema.Attribute{
 				"label": schema.StringAttribute{
@@ -426,6 +787,39 @@ func axisBlock() schema.Block {
 	}
 }
 
+func scaleBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Can be used to configure the scale of the y-axis.",
+		MarkdownDescription: "Can be used to configure the scale of the y-axis. " +
+			"Another way visualize series that differ by orders of magnitude is to use a logarithmic scales. " +
+			"This is really useful for data usage or latency measurements. " +
+			"The goal here is to avoid one series dominating and delegating all the others to the bottom of the graph.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					Description:         "The type of the scale. The choices are: linear, log.",
+					MarkdownDescription: "The type of the scale. The choices are: `linear`, `log`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("linear", "log"),
+					},
+				},
+				"log": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The power of the logarithmic scale. The choices are: 2, 10.",
+					MarkdownDescription: "The power of the logarithmic scale. The choices are: `2`, `10`.",
+					Validators: []validator.Int64{
+						int64validator.OneOf(2, 10),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
 func fieldBlock(includeThresholdsShowsAs bool) schema.Block {
 	var showAsAttribute schema.StringAttribute
 	if !includeThresholdsShowsAs {
@@ -820,84 +1214,339 @@ func queryBlock() schema.Block {
 						listvalidator.SizeAtLeast(1),
 					},
 				},
-				"expression": schema.ListNestedBlock{
-					Description: "The expression query.",
+				"loki": schema.ListNestedBlock{
+					Description: "The Loki query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"uid": schema.StringAttribute{
+								Description: "The UID of a Loki DataSource to use in this query.",
+								Required:    true,
+							},
+							"hide": schema.BoolAttribute{
+								Description: "Whether to hide query result from the panel or not.",
+								Optional:    true,
+							},
+							"expr": schema.StringAttribute{
+								Required:    true,
+								Description: "The LogQL query.",
+							},
+							"query_type": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The type of the query. The choices are: range, instant. Defaults to range.",
+								MarkdownDescription: "The type of the query. The choices are: `range`, `instant`. Defaults to `range`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("range", "instant"),
+								},
+							},
+							"legend_format": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The legend format of the metric queries. For example: {{host}}.",
+								MarkdownDescription: "The legend format of the metric queries. For example: `{{host}}`.",
+							},
+							"max_lines": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of log lines to return. Overrides the limit of the data source.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"resolution": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "The resolution of the metric queries. The value N means 1/N of the points. The choices are: 1, 2, 3, 4, 5, 10.",
+								MarkdownDescription: "The resolution of the metric queries. The value `N` means `1/N` of the points. The choices are: `1`, `2`, `3`, `4`, `5`, `10`.",
+								Validators: []validator.Int64{
+									int64validator.OneOf(1, 2, 3, 4, 5, 10),
+								},
+							},
+							"step": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The step of the metric queries. For example: 1m or $__interval.",
+								MarkdownDescription: "The step of the metric queries. For example: `1m` or `$__interval`.",
+							},
+							"direction": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The order of the log lines. The choices are: backward, forward. Defaults to backward.",
+								MarkdownDescription: "The order of the log lines. The choices are: `backward`, `forward`. Defaults to `backward`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("backward", "forward"),
+								},
+							},
+							"ref_id": schema.StringAttribute{
+								Optional:    true,
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(26),
+					},
+				},
+				"elasticsearch": elasticsearchQueryBlock("Elasticsearch"),
+				"opensearch":    elasticsearchQueryBlock("OpenSearch"),
+				"influxdb": schema.ListNestedBlock{
+					Description: "The InfluxDB query.",
 					NestedObject: schema.NestedBlockObject{
 						Blocks: map[string]schema.Block{
-							"math": schema.ListNestedBlock{
-								Description: "Math is for free-form math formulas on time series or number data. " +
-									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#math.",
-								MarkdownDescription: "Math is for free-form math formulas on time series or number data. " +
-									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#math).",
+							"influxql": schema.ListNestedBlock{
+								Description: "The raw InfluxQL query.",
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
-										"expression": schema.StringAttribute{
-											Description: "The math expression to evaluate.",
+										"query": schema.StringAttribute{
 											Required:    true,
+											Description: "The InfluxQL query. For example: SELECT mean(\"value\") FROM \"cpu\" WHERE $timeFilter GROUP BY time($__interval).",
+											MarkdownDescription: "The InfluxQL query. For example: " +
+												"`SELECT mean(\"value\") FROM \"cpu\" WHERE $timeFilter GROUP BY time($__interval)`.",
+										},
+										"result_format": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The format of the result. The choices are: time_series, table, logs. Defaults to time_series.",
+											MarkdownDescription: "The format of the result. The choices are: `time_series`, `table`, `logs`. Defaults to `time_series`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("time_series", "table", "logs"),
+											},
+										},
+										"alias": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The legend name. For example: $tag_host.",
+											MarkdownDescription: "The legend name. For example: `$tag_host`.",
 										},
 									},
 								},
-								Validators: []validator.List{
-									listvalidator.SizeAtMost(26),
-									listvalidator.ConflictsWith(
-										path.MatchRelative().AtParent().AtName("reduce"),
-										path.MatchRelative().AtParent().AtName("resample"),
-									),
-								},
+								Validators: requiredBlockValidators("influxql", []string{"influxql", "flux", "sql"}),
 							},
-							"reduce": schema.ListNestedBlock{
-								Description: "Reduce takes one or more time series returned from a query or an expression and turns each series into a single number. " +
-									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#reduce.",
-								MarkdownDescription: "Reduce takes one or more time series returned from a query or an expression and turns each series into a single number. " +
-									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#reduce).",
+							"flux": schema.ListNestedBlock{
+								Description: "The Flux query. The DataSource must be configured to use Flux.",
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
-										"function": schema.StringAttribute{
-											Required:            true,
-											Description:         "The reduction function to use. The choices are: min, max, mean, sum, count, last.",
-											MarkdownDescription: "The reduction function to use. The choices are: `min`, `max`, `mean`, `sum`, `count`, `last`.",
-											Validators: []validator.String{
-												stringvalidator.OneOf("min", "max", "mean", "sum", "count", "last"),
-											},
+										"query": schema.StringAttribute{
+											Required:    true,
+											Description: "The Flux query.",
 										},
-										"input": schema.StringAttribute{
-											Required:            true,
-											Description:         "The variable (refID (such as A)) to resample.",
-											MarkdownDescription: "The variable (refID (such as `A`)) to resample.",
+									},
+								},
+								Validators: requiredBlockValidators("flux", []string{"influxql", "flux", "sql"}),
+							},
+							"sql": schema.ListNestedBlock{
+								Description: "The SQL query of InfluxDB v3. The DataSource must be configured to use SQL.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"query": schema.StringAttribute{
+											Required:    true,
+											Description: "The SQL query.",
 										},
-										"mode": schema.StringAttribute{
+										"format": schema.StringAttribute{
 											Optional:            true,
-											Description:         "Allows control behavior of reduction function when a series contains non-numerical values. The choices are: strict, drop, replace.",
-											MarkdownDescription: "Allows control behavior of reduction function when a series contains non-numerical values. The choices are: `strict`, `drop`, `replace`.",
+											Description:         "The format of the result. The choices are: time_series, table. Defaults to time_series.",
+											MarkdownDescription: "The format of the result. The choices are: `time_series`, `table`. Defaults to `time_series`.",
 											Validators: []validator.String{
-												stringvalidator.OneOf("strict", "drop", "replace"),
+												stringvalidator.OneOf("time_series", "table"),
 											},
 										},
-										"replace_with": schema.Float64Attribute{
-											Optional:    true,
-											Description: "Effective when mode=replace. Replaces null, -inf, and +inf with the given value.",
-										},
 									},
 								},
-								Validators: []validator.List{
-									listvalidator.SizeAtMost(26),
-									listvalidator.ConflictsWith(
-										path.MatchRelative().AtParent().AtName("math"),
-										path.MatchRelative().AtParent().AtName("resample"),
-									),
-								},
+								Validators: requiredBlockValidators("sql", []string{"influxql", "flux", "sql"}),
 							},
-							"resample": schema.ListNestedBlock{
-								Description: "Resample changes the time stamps in each time series to have a consistent time interval. " +
-									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#resample.",
-								MarkdownDescription: "Resample changes the time stamps in each time series to have a consistent time interval. " +
-									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#resample).",
-								NestedObject: schema.NestedBlockObject{
-									Attributes: map[string]schema.Attribute{
-										"input": schema.StringAttribute{
-											Required:            true,
-											Description:         "The variable (refID (such as A)) to resample.",
-											MarkdownDescription: "The variable (refID (such as `A`)) to resample.",
+						},
+						Attributes: map[string]schema.Attribute{
+							"uid": schema.StringAttribute{
+								Description: "The UID of an InfluxDB DataSource to use in this query.",
+								Required:    true,
+							},
+							"hide": schema.BoolAttribute{
+								Description: "Whether to hide query result from the panel or not.",
+								Optional:    true,
+							},
+							"ref_id": schema.StringAttribute{
+								Optional:    true,
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(26),
+					},
+				},
+				"postgres": sqlQueryBlock("PostgreSQL"),
+				"mysql":    sqlQueryBlock("MySQL"),
+				"mssql":    sqlQueryBlock("Microsoft SQL Server"),
+				"graphite": schema.ListNestedBlock{
+					Description: "The Graphite query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"uid": schema.StringAttribute{
+								Description: "The UID of a Graphite DataSource to use in this query.",
+								Required:    true,
+							},
+							"hide": schema.BoolAttribute{
+								Description: "Whether to hide query result from the panel or not.",
+								Optional:    true,
+							},
+							"target": schema.StringAttribute{
+								Required: true,
+								Description: "The Graphite target expression. For example: aliasByNode(servers.*.cpu.load, 1). " +
+									"Other Graphite queries can be referenced by their ID, for example: asPercent(#A, #B).",
+								MarkdownDescription: "The Graphite target expression. For example: `aliasByNode(servers.*.cpu.load, 1)`. " +
+									"Other Graphite queries can be referenced by their ID, for example: `asPercent(#A, #B)`.",
+							},
+							"text_editor": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the query in the raw text editor or in the query builder in Grafana.",
+							},
+							"ref_id": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The ID of the query. The ID can be used to reference queries in math expressions and other Graphite queries. Must be a single letter from A to Z, as Graphite references only support these IDs.",
+								MarkdownDescription: "The ID of the query. The ID can be used to reference queries in math expressions and other Graphite queries. Must be a single letter from `A` to `Z`, as Graphite references only support these IDs.",
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(regexp.MustCompile("^[A-Z]$"), "Graphite references only support the IDs from A to Z"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(26),
+					},
+				},
+				"tempo":                   tempoQueryBlock(),
+				"jaeger":                  jaegerQueryBlock(),
+				"azure_monitor":           azureMonitorQueryBlock(),
+				"google_cloud_monitoring": cloudMonitoringQueryBlock(),
+				"testdata":                testDataQueryBlock(),
+				"pyroscope": schema.ListNestedBlock{
+					Description: "The Pyroscope profiling query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"uid": schema.StringAttribute{
+								Description: "The UID of a Pyroscope DataSource to use in this query.",
+								Required:    true,
+							},
+							"hide": schema.BoolAttribute{
+								Description: "Whether to hide query result from the panel or not.",
+								Optional:    true,
+							},
+							"profile_type_id": schema.StringAttribute{
+								Required:            true,
+								Description:         "The ID of the profile type. For example: process_cpu:cpu:nanoseconds:cpu:nanoseconds.",
+								MarkdownDescription: "The ID of the profile type. For example: `process_cpu:cpu:nanoseconds:cpu:nanoseconds`.",
+							},
+							"label_selector": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The label selector to filter the profiles. For example: {service_name=\"backend\"}. Defaults to {}.",
+								MarkdownDescription: "The label selector to filter the profiles. For example: `{service_name=\"backend\"}`. Defaults to `{}`.",
+							},
+							"group_by": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The labels to group the metrics by.",
+							},
+							"max_nodes": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of nodes to return in the flame graph.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"query_type": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The type of the data to return. The choices are: profile, metrics, both. Defaults to profile.",
+								MarkdownDescription: "The type of the data to return. The choices are: `profile`, `metrics`, `both`. Defaults to `profile`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("profile", "metrics", "both"),
+								},
+							},
+							"ref_id": schema.StringAttribute{
+								Optional:    true,
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(26),
+					},
+				},
+				"expression": schema.ListNestedBlock{
+					Description: "The expression query.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"math": schema.ListNestedBlock{
+								Description: "Math is for free-form math formulas on time series or number data. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#math.",
+								MarkdownDescription: "Math is for free-form math formulas on time series or number data. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#math).",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"expression": schema.StringAttribute{
+											Description: "The math expression to evaluate.",
+											Required:    true,
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(26),
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("reduce"),
+										path.MatchRelative().AtParent().AtName("resample"),
+										path.MatchRelative().AtParent().AtName("threshold"),
+										path.MatchRelative().AtParent().AtName("classic_conditions"),
+										path.MatchRelative().AtParent().AtName("sql"),
+									),
+								},
+							},
+							"reduce": schema.ListNestedBlock{
+								Description: "Reduce takes one or more time series returned from a query or an expression and turns each series into a single number. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#reduce.",
+								MarkdownDescription: "Reduce takes one or more time series returned from a query or an expression and turns each series into a single number. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#reduce).",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"function": schema.StringAttribute{
+											Required:            true,
+											Description:         "The reduction function to use. The choices are: min, max, mean, sum, count, last.",
+											MarkdownDescription: "The reduction function to use. The choices are: `min`, `max`, `mean`, `sum`, `count`, `last`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("min", "max", "mean", "sum", "count", "last"),
+											},
+										},
+										"input": schema.StringAttribute{
+											Required:            true,
+											Description:         "The variable (refID (such as A)) to resample.",
+											MarkdownDescription: "The variable (refID (such as `A`)) to resample.",
+										},
+										"mode": schema.StringAttribute{
+											Optional:            true,
+											Description:         "Allows control behavior of reduction function when a series contains non-numerical values. The choices are: strict, drop, replace.",
+											MarkdownDescription: "Allows control behavior of reduction function when a series contains non-numerical values. The choices are: `strict`, `drop`, `replace`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("strict", "drop", "replace"),
+											},
+										},
+										"replace_with": schema.Float64Attribute{
+											Optional:    true,
+											Description: "Effective when mode=replace. Replaces null, -inf, and +inf with the given value.",
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(26),
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("math"),
+										path.MatchRelative().AtParent().AtName("resample"),
+										path.MatchRelative().AtParent().AtName("threshold"),
+										path.MatchRelative().AtParent().AtName("classic_conditions"),
+										path.MatchRelative().AtParent().AtName("sql"),
+									),
+								},
+							},
+							"resample": schema.ListNestedBlock{
+								Description: "Resample changes the time stamps in each time series to have a consistent time interval. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#resample.",
+								MarkdownDescription: "Resample changes the time stamps in each time series to have a consistent time interval. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#resample).",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"input": schema.StringAttribute{
+											Required:            true,
+											Description:         "The variable (refID (such as A)) to resample.",
+											MarkdownDescription: "The variable (refID (such as `A`)) to resample.",
 										},
 										"to": schema.StringAttribute{
 											Required:            true,
@@ -927,6 +1576,172 @@ func queryBlock() schema.Block {
 									listvalidator.ConflictsWith(
 										path.MatchRelative().AtParent().AtName("math"),
 										path.MatchRelative().AtParent().AtName("reduce"),
+										path.MatchRelative().AtParent().AtName("threshold"),
+										path.MatchRelative().AtParent().AtName("classic_conditions"),
+										path.MatchRelative().AtParent().AtName("sql"),
+									),
+								},
+							},
+							"threshold": schema.ListNestedBlock{
+								Description: "Threshold checks if any time series data matches the threshold condition. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#threshold.",
+								MarkdownDescription: "Threshold checks if any time series data matches the threshold condition. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#threshold).",
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"hysteresis": schema.ListNestedBlock{
+											Description: "The custom recovery threshold. The firing threshold stops firing only when the recovery threshold is crossed.",
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"params": schema.ListAttribute{
+														Required:    true,
+														ElementType: types.Float64Type,
+														Description: "The values of the recovery threshold. The evaluator of the recovery threshold is the opposite of the firing one.",
+														Validators: []validator.List{
+															listvalidator.SizeBetween(1, 2),
+															evaluatorParamsValidator{parents: 3},
+														},
+													},
+												},
+											},
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+										},
+									},
+									Attributes: map[string]schema.Attribute{
+										"input": schema.StringAttribute{
+											Required:            true,
+											Description:         "The variable (refID (such as A)) to check.",
+											MarkdownDescription: "The variable (refID (such as `A`)) to check.",
+										},
+										"evaluator": schema.StringAttribute{
+											Required:            true,
+											Description:         "The condition to check the values with. The choices are: gt, lt, within_range, outside_range.",
+											MarkdownDescription: "The condition to check the values with. The choices are: `gt`, `lt`, `within_range`, `outside_range`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("gt", "lt", "within_range", "outside_range"),
+											},
+										},
+										"params": schema.ListAttribute{
+											Required:    true,
+											ElementType: types.Float64Type,
+											Description: "The values to compare with. One value for gt and lt, two values for within_range and outside_range.",
+											MarkdownDescription: "The values to compare with. One value for `gt` and `lt`, " +
+												"two values for `within_range` and `outside_range`.",
+											Validators: []validator.List{
+												listvalidator.SizeBetween(1, 2),
+												evaluatorParamsValidator{parents: 1},
+											},
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(26),
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("math"),
+										path.MatchRelative().AtParent().AtName("reduce"),
+										path.MatchRelative().AtParent().AtName("resample"),
+										path.MatchRelative().AtParent().AtName("classic_conditions"),
+										path.MatchRelative().AtParent().AtName("sql"),
+									),
+								},
+							},
+							"classic_conditions": schema.ListNestedBlock{
+								Description: "Classic condition checks the reduced values of the time series against the conditions combined with AND/OR. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#classic-condition-legacy.",
+								MarkdownDescription: "Classic condition checks the reduced values of the time series against the conditions combined with AND/OR. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/expression-queries/#classic-condition-legacy).",
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"condition": schema.ListNestedBlock{
+											Description: "The condition. The conditions are evaluated in the order of declaration.",
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"operator": schema.StringAttribute{
+														Optional:            true,
+														Description:         "The operator to combine the condition with the previous ones. The choices are: and, or. Defaults to and.",
+														MarkdownDescription: "The operator to combine the condition with the previous ones. The choices are: `and`, `or`. Defaults to `and`.",
+														Validators: []validator.String{
+															stringvalidator.OneOf("and", "or"),
+														},
+													},
+													"query": schema.StringAttribute{
+														Required:            true,
+														Description:         "The variable (refID (such as A)) to check.",
+														MarkdownDescription: "The variable (refID (such as `A`)) to check.",
+													},
+													"reducer": schema.StringAttribute{
+														Required: true,
+														Description: "The reduction function to use. The choices are: avg, min, max, sum, count, last, median, diff, diff_abs, " +
+															"percent_diff, percent_diff_abs, count_non_null.",
+														MarkdownDescription: "The reduction function to use. The choices are: `avg`, `min`, `max`, `sum`, `count`, `last`, `median`, " +
+															"`diff`, `diff_abs`, `percent_diff`, `percent_diff_abs`, `count_non_null`.",
+														Validators: []validator.String{
+															stringvalidator.OneOf("avg", "min", "max", "sum", "count", "last", "median", "diff", "diff_abs",
+																"percent_diff", "percent_diff_abs", "count_non_null"),
+														},
+													},
+													"evaluator": schema.StringAttribute{
+														Required:            true,
+														Description:         "The condition to check the reduced value with. The choices are: gt, lt, within_range, outside_range, no_value.",
+														MarkdownDescription: "The condition to check the reduced value with. The choices are: `gt`, `lt`, `within_range`, `outside_range`, `no_value`.",
+														Validators: []validator.String{
+															stringvalidator.OneOf("gt", "lt", "within_range", "outside_range", "no_value"),
+														},
+													},
+													"params": schema.ListAttribute{
+														Optional:    true,
+														ElementType: types.Float64Type,
+														Description: "The values to compare with. One value for gt and lt, two values for within_range and outside_range.",
+														MarkdownDescription: "The values to compare with. One value for `gt` and `lt`, " +
+															"two values for `within_range` and `outside_range`.",
+														Validators: []validator.List{
+															listvalidator.SizeBetween(1, 2),
+															evaluatorParamsValidator{parents: 1},
+														},
+													},
+												},
+											},
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(26),
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("math"),
+										path.MatchRelative().AtParent().AtName("reduce"),
+										path.MatchRelative().AtParent().AtName("resample"),
+										path.MatchRelative().AtParent().AtName("threshold"),
+										path.MatchRelative().AtParent().AtName("sql"),
+									),
+								},
+							},
+							"sql": schema.ListNestedBlock{
+								Description: "SQL expression joins and reshapes the results of other queries with SQL. The queries are referenced as tables by their refID. " +
+									"See the documentation https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/sql-expressions/.",
+								MarkdownDescription: "SQL expression joins and reshapes the results of other queries with SQL. The queries are referenced as tables by their refID. " +
+									"See the [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/query-transform-data/sql-expressions/).",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"query": schema.StringAttribute{
+											Required:            true,
+											Description:         "The SQL query. For example: SELECT A.time, A.value / B.value AS ratio FROM A JOIN B ON A.time = B.time.",
+											MarkdownDescription: "The SQL query. For example: `SELECT A.time, A.value / B.value AS ratio FROM A JOIN B ON A.time = B.time`.",
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(26),
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("math"),
+										path.MatchRelative().AtParent().AtName("reduce"),
+										path.MatchRelative().AtParent().AtName("resample"),
+										path.MatchRelative().AtParent().AtName("threshold"),
+										path.MatchRelative().AtParent().AtName("classic_conditions"),
 									),
 								},
 							},
@@ -953,6 +1768,9 @@ func queryBlock() schema.Block {
 					Description: "The lower bounds on the interval between data points.",
 				},
 			},
+			Validators: []validator.Object{
+				sqlExpressionReferencesValidator{},
+			},
 		},
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(3),
@@ -960,367 +1778,2224 @@ func queryBlock() schema.Block {
 	}
 }
 
-func mappingsBlock() schema.Block {
+func elasticsearchQueryBlock(name string) schema.Block {
+	metricTypes := []string{"count", "avg", "sum", "min", "max", "percentiles", "cardinality", "raw_data", "logs"}
+	bucketAggregationTypes := []string{"date_histogram", "terms", "filters", "histogram", "geohash_grid"}
+
+	fieldMetricBlock := func(typeName string, description string) schema.Block {
+		return schema.ListNestedBlock{
+			Description: description,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						Required:    true,
+						Description: "The field to aggregate.",
+					},
+				},
+			},
+			Validators: requiredBlockValidators(typeName, metricTypes),
+		}
+	}
+
 	return schema.ListNestedBlock{
-		Description: "The set of rules that translate a field value or range of values into explicit text.",
+		Description: "The " + name + " query.",
 		NestedObject: schema.NestedBlockObject{
 			Blocks: map[string]schema.Block{
-				"value": schema.ListNestedBlock{
-					Description: "Match a specific text value.",
+				"metric": schema.ListNestedBlock{
+					Description: "The metric aggregation. The metric aggregations are numbered from 1 in the order of declaration. Defaults to count.",
+					MarkdownDescription: "The metric aggregation. The metric aggregations are numbered from `1` in the order of declaration. " +
+						"Defaults to `count`.",
 					NestedObject: schema.NestedBlockObject{
-						Attributes: map[string]schema.Attribute{
-							"value": schema.StringAttribute{
-								Required:    true,
-								Description: "The exact value to match.",
-							},
-							"display_text": schema.StringAttribute{
-								Optional:    true,
-								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
-							},
-							"color": schema.StringAttribute{
-								Optional:    true,
-								Description: "The color to use if the condition is met.",
+						Blocks: map[string]schema.Block{
+							"count": schema.ListNestedBlock{
+								Description:  "The number of documents.",
+								NestedObject: schema.NestedBlockObject{},
+								Validators:   requiredBlockValidators("count", metricTypes),
 							},
-						},
-					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(10),
-					},
-				},
-				"range": schema.ListNestedBlock{
-					Description: "Match a numerical range of values.",
-					NestedObject: schema.NestedBlockObject{
-						Attributes: map[string]schema.Attribute{
-							"from": schema.Float64Attribute{
-								Required:    true,
-								Description: "The start of the range.",
-							},
-							"to": schema.Float64Attribute{
-								Required:    true,
-								Description: "The end of the range.",
+							"avg":         fieldMetricBlock("avg", "The average of the field values."),
+							"sum":         fieldMetricBlock("sum", "The sum of the field values."),
+							"min":         fieldMetricBlock("min", "The minimum of the field values."),
+							"max":         fieldMetricBlock("max", "The maximum of the field values."),
+							"cardinality": fieldMetricBlock("cardinality", "The approximate count of distinct field values."),
+							"percentiles": schema.ListNestedBlock{
+								Description: "The percentiles of the field values.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Required:    true,
+											Description: "The field to aggregate.",
+										},
+										"percents": schema.ListAttribute{
+											Required:            true,
+											ElementType:         types.Float64Type,
+											Description:         "The percentiles to calculate. For example: [25, 50, 75, 95, 99].",
+											MarkdownDescription: "The percentiles to calculate. For example: `[25, 50, 75, 95, 99]`.",
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("percentiles", metricTypes),
 							},
-							"display_text": schema.StringAttribute{
-								Optional:    true,
-								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							"raw_data": schema.ListNestedBlock{
+								Description: "The raw documents. Bucket aggregations cannot be used with raw data.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"size": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The maximum number of documents to return. Defaults to 500.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("raw_data", metricTypes),
 							},
-							"color": schema.StringAttribute{
-								Optional:    true,
-								Description: "The color to use if the condition is met.",
+							"logs": schema.ListNestedBlock{
+								Description: "The documents as log lines. Bucket aggregations cannot be used with logs.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"limit": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The maximum number of log lines to return. Defaults to 500.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("logs", metricTypes),
 							},
 						},
-					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(10),
-					},
-				},
-				"regex": schema.ListNestedBlock{
-					Description: "Match a regular expression with replacement.",
-					NestedObject: schema.NestedBlockObject{
 						Attributes: map[string]schema.Attribute{
-							"pattern": schema.StringAttribute{
-								Required:    true,
-								Description: "The regular expression to match.",
-							},
-							"display_text": schema.StringAttribute{
-								Optional:    true,
-								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
-							},
-							"color": schema.StringAttribute{
+							"hide": schema.BoolAttribute{
 								Optional:    true,
-								Description: "The color to use if the condition is met.",
+								Description: "Whether to hide the metric from the query result or not.",
 							},
 						},
 					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(10),
-					},
 				},
-				"special": schema.ListNestedBlock{
-					Description: "Match on null, NaN, boolean and empty values.",
+				"bucket_aggregation": schema.ListNestedBlock{
+					Description: "The bucket aggregation. The bucket aggregations are nested in the order of declaration. " +
+						"Defaults to a date histogram unless raw data or logs are queried.",
 					NestedObject: schema.NestedBlockObject{
-						Attributes: map[string]schema.Attribute{
-							"match": schema.StringAttribute{
-								Optional:            true,
-								Description:         "The category to match. The choices are: null, nan, null+nan, true, false, empty.",
-								MarkdownDescription: "The category to match. The choices are: `null`, `nan`, `null+nan`, `true`, `false`, `empty`.",
-								Validators: []validator.String{
-									stringvalidator.OneOf("null", "nan", "null+nan", "true", "false", "empty"),
+						Blocks: map[string]schema.Block{
+							"date_histogram": schema.ListNestedBlock{
+								Description: "Groups the documents by time intervals.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Optional:    true,
+											Description: "The time field to group by. Defaults to the time field of the query.",
+										},
+										"interval": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The interval of the buckets. For example: auto, 10s, 1m, 1h. Defaults to auto.",
+											MarkdownDescription: "The interval of the buckets. For example: `auto`, `10s`, `1m`, `1h`. Defaults to `auto`.",
+										},
+										"min_doc_count": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The minimum number of documents in a bucket. Defaults to 0.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+										"trim_edges": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The number of buckets to trim from both edges of the histogram. Defaults to 0.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+										"offset": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The offset of the buckets. For example: 1h.",
+											MarkdownDescription: "The offset of the buckets. For example: `1h`.",
+										},
+									},
 								},
+								Validators: requiredBlockValidators("date_histogram", bucketAggregationTypes),
 							},
-							"display_text": schema.StringAttribute{
-								Optional:    true,
-								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							"terms": schema.ListNestedBlock{
+								Description: "Groups the documents by the values of a field.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Required:    true,
+											Description: "The field to group by.",
+										},
+										"size": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The maximum number of buckets. The value 0 means no limit. Defaults to 10.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+										"order": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The order of the buckets. The choices are: asc, desc. Defaults to desc.",
+											MarkdownDescription: "The order of the buckets. The choices are: `asc`, `desc`. Defaults to `desc`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("asc", "desc"),
+											},
+										},
+										"order_by": schema.StringAttribute{
+											Optional: true,
+											Description: "What to order the buckets by. The choices are: _term, _count or the number of a metric aggregation. " +
+												"Defaults to _term.",
+											MarkdownDescription: "What to order the buckets by. The choices are: `_term`, `_count` or the number of a metric aggregation. " +
+												"Defaults to `_term`.",
+										},
+										"min_doc_count": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The minimum number of documents in a bucket. Defaults to 1.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("terms", bucketAggregationTypes),
 							},
-							"color": schema.StringAttribute{
-								Optional:    true,
-								Description: "The color to use if the condition is met.",
+							"filters": schema.ListNestedBlock{
+								Description: "Groups the documents by Lucene queries.",
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"filter": schema.ListNestedBlock{
+											Description: "The filter that defines a bucket.",
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"query": schema.StringAttribute{
+														Required:    true,
+														Description: "The Lucene query of the bucket.",
+													},
+													"label": schema.StringAttribute{
+														Optional:    true,
+														Description: "The label of the bucket.",
+													},
+												},
+											},
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("filters", bucketAggregationTypes),
+							},
+							"histogram": schema.ListNestedBlock{
+								Description: "Groups the documents by numeric intervals.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Required:    true,
+											Description: "The numeric field to group by.",
+										},
+										"interval": schema.Float64Attribute{
+											Optional:    true,
+											Description: "The interval of the buckets. Defaults to 1000.",
+										},
+										"min_doc_count": schema.Int64Attribute{
+											Optional:    true,
+											Description: "The minimum number of documents in a bucket. Defaults to 0.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("histogram", bucketAggregationTypes),
+							},
+							"geohash_grid": schema.ListNestedBlock{
+								Description: "Groups the documents by geohash cells.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Required:    true,
+											Description: "The geo-point field to group by.",
+										},
+										"precision": schema.Int64Attribute{
+											Optional:            true,
+											Description:         "The precision of the geohash. Must be between 1 and 12 (inclusive). Defaults to 3.",
+											MarkdownDescription: "The precision of the geohash. Must be between `1` and `12` (inclusive). Defaults to `3`.",
+											Validators: []validator.Int64{
+												int64validator.Between(1, 12),
+											},
+										},
+									},
+								},
+								Validators: requiredBlockValidators("geohash_grid", bucketAggregationTypes),
 							},
 						},
 					},
 					Validators: []validator.List{
-						listvalidator.SizeAtMost(10),
+						elasticsearchBucketAggregationValidator{},
 					},
 				},
 			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of an " + name + " DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"query": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The Lucene query. For example: status:500 AND service:backend.",
+					MarkdownDescription: "The Lucene query. For example: `status:500 AND service:backend`.",
+				},
+				"alias": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The legend name. For example: {{term host}}.",
+					MarkdownDescription: "The legend name. For example: `{{term host}}`.",
+				},
+				"time_field": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The name of the time field. For example: @timestamp.",
+					MarkdownDescription: "The name of the time field. For example: `@timestamp`.",
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
 		},
 		Validators: []validator.List{
-			listvalidator.SizeAtMost(1),
+			listvalidator.SizeAtMost(26),
 		},
 	}
 }
 
-func fieldOverrideBlock(includeThresholdsShowsAs bool) schema.Block {
+func sqlQueryBlock(name string) schema.Block {
 	return schema.ListNestedBlock{
-		Description: "The set of rules that override attributes of a field.",
+		Description: "The " + name + " query.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of a " + name + " DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"raw_sql": schema.StringAttribute{
+					Required: true,
+					Description: "The SQL query. The time series queries must return a time column. " +
+						"For example: SELECT $__timeGroupAlias(created_at, $__interval), count(*) AS value FROM orders WHERE $__timeFilter(created_at) GROUP BY 1 ORDER BY 1.",
+					MarkdownDescription: "The SQL query. The time series queries must return a `time` column. " +
+						"For example: `SELECT $__timeGroupAlias(created_at, $__interval), count(*) AS value FROM orders WHERE $__timeFilter(created_at) GROUP BY 1 ORDER BY 1`.",
+				},
+				"format": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The format of the result. The choices are: time_series, table, logs. Defaults to table.",
+					MarkdownDescription: "The format of the result. The choices are: `time_series`, `table`, `logs`. Defaults to `table`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("time_series", "table", "logs"),
+					},
+				},
+				"editor_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The mode of the query editor in Grafana. The choices are: code, builder. Defaults to code.",
+					MarkdownDescription: "The mode of the query editor in Grafana. The choices are: `code`, `builder`. Defaults to `code`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("code", "builder"),
+					},
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(26),
+		},
+	}
+}
+
+func tempoQueryBlock() schema.Block {
+	queryTypes := []string{"search", "trace", "service_graph", "metrics"}
+
+	return schema.ListNestedBlock{
+		Description: "The Tempo query.",
 		NestedObject: schema.NestedBlockObject{
 			Blocks: map[string]schema.Block{
-				"by_name": schema.ListNestedBlock{
-					Description: "Override properties for a field with a specific name.",
+				"search": schema.ListNestedBlock{
+					Description: "Searches the traces with a TraceQL query.",
 					NestedObject: schema.NestedBlockObject{
-						Blocks: map[string]schema.Block{
-							"field": fieldBlock(includeThresholdsShowsAs),
-						},
 						Attributes: map[string]schema.Attribute{
-							"name": schema.StringAttribute{
-								Required:    true,
-								Description: "The name of the field to override attributes for.",
+							"query": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The TraceQL query. For example: { resource.service.name = \"backend\" && status = error }. Defaults to {}.",
+								MarkdownDescription: "The TraceQL query. For example: `{ resource.service.name = \"backend\" && status = error }`. Defaults to `{}`.",
+							},
+							"limit": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of traces to return.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"span_limit": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of spans to return for each span set.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"table_type": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Whether to show the results as traces or as spans. The choices are: traces, spans. Defaults to traces.",
+								MarkdownDescription: "Whether to show the results as traces or as spans. The choices are: `traces`, `spans`. Defaults to `traces`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("traces", "spans"),
+								},
 							},
 						},
 					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(100),
-					},
+					Validators: requiredBlockValidators("search", queryTypes),
 				},
-				"by_regex": schema.ListNestedBlock{
-					Description: "Override properties for a field with a matching name.",
+				"trace": traceIDQueryBlock(requiredBlockValidators("trace", queryTypes)),
+				"service_graph": schema.ListNestedBlock{
+					Description: "Queries the service graph generated from the spans.",
 					NestedObject: schema.NestedBlockObject{
-						Blocks: map[string]schema.Block{
-							"field": fieldBlock(includeThresholdsShowsAs),
-						},
 						Attributes: map[string]schema.Attribute{
-							"regex": schema.StringAttribute{
-								Required:    true,
-								Description: "The regex the field's name should match.",
+							"filter": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The filter of the service graph metrics. For example: {server=\"backend\"}.",
+								MarkdownDescription: "The filter of the service graph metrics. For example: `{server=\"backend\"}`.",
+							},
+							"include_namespace": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to include the namespace in the service names or not.",
 							},
 						},
 					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(100),
-					},
+					Validators: requiredBlockValidators("service_graph", queryTypes),
 				},
-				"by_type": schema.ListNestedBlock{
-					Description: "Override properties for a field with a specific type.",
+				"metrics": schema.ListNestedBlock{
+					Description: "Calculates metrics from the spans with a TraceQL metrics query.",
 					NestedObject: schema.NestedBlockObject{
-						Blocks: map[string]schema.Block{
-							"field": fieldBlock(includeThresholdsShowsAs),
-						},
 						Attributes: map[string]schema.Attribute{
+							"query": schema.StringAttribute{
+								Required:            true,
+								Description:         "The TraceQL metrics query. For example: { status = error } | rate() by (resource.service.name).",
+								MarkdownDescription: "The TraceQL metrics query. For example: `{ status = error } | rate() by (resource.service.name)`.",
+							},
 							"type": schema.StringAttribute{
-								Required:    true,
-								Description: "The type of the field to override attributes for.",
+								Optional:            true,
+								Description:         "The type of the metrics query. The choices are: range, instant. Defaults to range.",
+								MarkdownDescription: "The type of the metrics query. The choices are: `range`, `instant`. Defaults to `range`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("range", "instant"),
+								},
 							},
 						},
 					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(100),
-					},
+					Validators: requiredBlockValidators("metrics", queryTypes),
 				},
-				"by_query_id": schema.ListNestedBlock{
-					Description: "Override properties for a field returned by a specific query.",
-					NestedObject: schema.NestedBlockObject{
-						Blocks: map[string]schema.Block{
-							"field": fieldBlock(includeThresholdsShowsAs),
-						},
-						Attributes: map[string]schema.Attribute{
-							"query_id": schema.StringAttribute{
+			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of a Tempo DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(26),
+		},
+	}
+}
+
+func jaegerQueryBlock() schema.Block {
+	queryTypes := []string{"search", "trace"}
+
+	return schema.ListNestedBlock{
+		Description: "The Jaeger query.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"search": schema.ListNestedBlock{
+					Description: "Searches the traces of a service.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"service": schema.StringAttribute{
 								Required:    true,
-								Description: "The name of the field to override attributes for.",
+								Description: "The name of the service.",
+							},
+							"operation": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the operation.",
+							},
+							"tags": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The tags to filter the spans with in the logfmt format. For example: http.status_code=500 error=true.",
+								MarkdownDescription: "The tags to filter the spans with in the logfmt format. For example: `http.status_code=500 error=true`.",
+							},
+							"min_duration": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The minimum duration of the traces. For example: 100ms, 1.2s.",
+								MarkdownDescription: "The minimum duration of the traces. For example: `100ms`, `1.2s`.",
+							},
+							"max_duration": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The maximum duration of the traces. For example: 100ms, 1.2s.",
+								MarkdownDescription: "The maximum duration of the traces. For example: `100ms`, `1.2s`.",
+							},
+							"limit": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of traces to return.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
 							},
 						},
 					},
-					Validators: []validator.List{
-						listvalidator.SizeAtMost(100),
-					},
+					Validators: requiredBlockValidators("search", queryTypes),
+				},
+				"trace": traceIDQueryBlock(requiredBlockValidators("trace", queryTypes)),
+			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of a Jaeger DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
 				},
 			},
 		},
 		Validators: []validator.List{
-			listvalidator.SizeAtMost(10),
+			listvalidator.SizeAtMost(26),
 		},
 	}
 }
 
-// attributes
-func idAttribute() schema.StringAttribute {
-	return schema.StringAttribute{
-		Computed: true,
+func azureMonitorQueryBlock() schema.Block {
+	queryTypes := []string{"metrics", "logs", "resource_graph"}
+
+	return schema.ListNestedBlock{
+		Description: "The Azure Monitor query.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"metrics": schema.ListNestedBlock{
+					Description: "The metrics query.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"dimension_filter": schema.ListNestedBlock{
+								Description: "The dimension to filter the metric with.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"dimension": schema.StringAttribute{
+											Required:    true,
+											Description: "The name of the dimension.",
+										},
+										"operator": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The operator of the filter. The choices are: eq, ne, sw. Defaults to eq.",
+											MarkdownDescription: "The operator of the filter. The choices are: `eq`, `ne`, `sw`. Defaults to `eq`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("eq", "ne", "sw"),
+											},
+										},
+										"values": schema.ListAttribute{
+											Optional:    true,
+											ElementType: types.StringType,
+											Description: "The values of the dimension. All values are matched when empty.",
+										},
+									},
+								},
+							},
+						},
+						Attributes: map[string]schema.Attribute{
+							"subscription": schema.StringAttribute{
+								Required:    true,
+								Description: "The ID of the subscription.",
+							},
+							"resource_group": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the resource group.",
+							},
+							"namespace": schema.StringAttribute{
+								Required:            true,
+								Description:         "The namespace of the resource. For example: Microsoft.Compute/virtualMachines.",
+								MarkdownDescription: "The namespace of the resource. For example: `Microsoft.Compute/virtualMachines`.",
+							},
+							"resource_name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the resource.",
+							},
+							"region": schema.StringAttribute{
+								Optional:    true,
+								Description: "The region of the resource.",
+							},
+							"metric_name": schema.StringAttribute{
+								Required:            true,
+								Description:         "The name of the metric. For example: Percentage CPU.",
+								MarkdownDescription: "The name of the metric. For example: `Percentage CPU`.",
+							},
+							"aggregation": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The aggregation of the metric. The choices are: None, Average, Minimum, Maximum, Total, Count.",
+								MarkdownDescription: "The aggregation of the metric. The choices are: `None`, `Average`, `Minimum`, `Maximum`, `Total`, `Count`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("None", "Average", "Minimum", "Maximum", "Total", "Count"),
+								},
+							},
+							"time_grain": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The time grain of the metric in the ISO 8601 format. For example: PT5M. Defaults to auto.",
+								MarkdownDescription: "The time grain of the metric in the ISO 8601 format. For example: `PT5M`. Defaults to `auto`.",
+							},
+							"top": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The maximum number of dimension values to return.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"alias": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The legend name. For example: {{ resourcename }}.",
+								MarkdownDescription: "The legend name. For example: `{{ resourcename }}`.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("metrics", queryTypes),
+				},
+				"logs": schema.ListNestedBlock{
+					Description: "The logs query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"query": schema.StringAttribute{
+								Required:    true,
+								Description: "The KQL query.",
+							},
+							"resources": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The IDs of the resources to query the logs from. For example: /subscriptions/<id>/resourceGroups/<name>/providers/Microsoft.OperationalInsights/workspaces/<name>.",
+								MarkdownDescription: "The IDs of the resources to query the logs from. " +
+									"For example: `/subscriptions/<id>/resourceGroups/<name>/providers/Microsoft.OperationalInsights/workspaces/<name>`.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"result_format": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The format of the result. The choices are: time_series, table, logs, trace. Defaults to table.",
+								MarkdownDescription: "The format of the result. The choices are: `time_series`, `table`, `logs`, `trace`. Defaults to `table`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("time_series", "table", "logs", "trace"),
+								},
+							},
+							"use_dashboard_time": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to use the time range of the dashboard instead of the time range of the query.",
+							},
+							"time_column": schema.StringAttribute{
+								Optional:    true,
+								Description: "The column to filter the results by the dashboard time. Effective when use_dashboard_time = true.",
+								MarkdownDescription: "The column to filter the results by the dashboard time. " +
+									"Effective when `use_dashboard_time = true`.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("logs", queryTypes),
+				},
+				"resource_graph": schema.ListNestedBlock{
+					Description: "The Resource Graph query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"query": schema.StringAttribute{
+								Required:            true,
+								Description:         "The KQL query. For example: Resources | summarize count() by type.",
+								MarkdownDescription: "The KQL query. For example: `Resources | summarize count() by type`.",
+							},
+							"subscriptions": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The IDs of the subscriptions to query.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+					Validators: requiredBlockValidators("resource_graph", queryTypes),
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of an Azure Monitor DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(26),
+		},
 	}
 }
 
-func jsonAttribute() schema.StringAttribute {
-	return schema.StringAttribute{
-		Computed:    true,
-		Description: "The Grafana-API-compatible JSON of this panel.",
+func cloudMonitoringQueryBlock() schema.Block {
+	queryTypes := []string{"builder", "mql", "promql"}
+
+	aligners := []string{
+		"ALIGN_NONE", "ALIGN_DELTA", "ALIGN_RATE", "ALIGN_INTERPOLATE", "ALIGN_NEXT_OLDER", "ALIGN_MIN", "ALIGN_MAX",
+		"ALIGN_MEAN", "ALIGN_COUNT", "ALIGN_SUM", "ALIGN_STDDEV", "ALIGN_COUNT_TRUE", "ALIGN_COUNT_FALSE",
+		"ALIGN_FRACTION_TRUE", "ALIGN_PERCENTILE_99", "ALIGN_PERCENTILE_95", "ALIGN_PERCENTILE_50",
+		"ALIGN_PERCENTILE_05", "ALIGN_PERCENT_CHANGE",
 	}
-}
 
-func compactJsonAttribute() schema.BoolAttribute {
-	return schema.BoolAttribute{
-		Optional:    true,
-		Description: "Whether to use compat JSON encoding or not.",
+	reducers := []string{
+		"REDUCE_NONE", "REDUCE_MEAN", "REDUCE_MIN", "REDUCE_MAX", "REDUCE_SUM", "REDUCE_STDDEV", "REDUCE_COUNT",
+		"REDUCE_COUNT_TRUE", "REDUCE_COUNT_FALSE", "REDUCE_FRACTION_TRUE", "REDUCE_PERCENTILE_99",
+		"REDUCE_PERCENTILE_95", "REDUCE_PERCENTILE_50", "REDUCE_PERCENTILE_05",
 	}
-}
 
-func titleAttribute() schema.StringAttribute {
-	return schema.StringAttribute{
+	projectAttribute := schema.StringAttribute{
 		Required:    true,
-		Description: "The title of this panel.",
+		Description: "The ID of the Google Cloud project.",
+	}
+
+	return schema.ListNestedBlock{
+		Description: "The Google Cloud Monitoring query.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"builder": schema.ListNestedBlock{
+					Description: "The metrics query built from the metric type, filters and aggregations.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"filter": schema.ListNestedBlock{
+								Description: "The filter of the time series. The filters are combined with AND.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"key": schema.StringAttribute{
+											Required:            true,
+											Description:         "The label to filter by. For example: resource.label.zone.",
+											MarkdownDescription: "The label to filter by. For example: `resource.label.zone`.",
+										},
+										"operator": schema.StringAttribute{
+											Optional:            true,
+											Description:         "The operator of the filter. The choices are: =, !=, =~, !=~. Defaults to =.",
+											MarkdownDescription: "The operator of the filter. The choices are: `=`, `!=`, `=~`, `!=~`. Defaults to `=`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("=", "!=", "=~", "!=~"),
+											},
+										},
+										"value": schema.StringAttribute{
+											Required:    true,
+											Description: "The value of the label.",
+										},
+									},
+								},
+							},
+						},
+						Attributes: map[string]schema.Attribute{
+							"project": projectAttribute,
+							"metric_type": schema.StringAttribute{
+								Required:            true,
+								Description:         "The type of the metric. For example: compute.googleapis.com/instance/cpu/utilization.",
+								MarkdownDescription: "The type of the metric. For example: `compute.googleapis.com/instance/cpu/utilization`.",
+							},
+							"aligner": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The function to align each time series with. The choices are: " + strings.Join(aligners, ", ") + ". Defaults to ALIGN_MEAN.",
+								MarkdownDescription: "The function to align each time series with. The choices are: `" + strings.Join(aligners, "`, `") + "`. Defaults to `ALIGN_MEAN`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(aligners...),
+								},
+							},
+							"reducer": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The function to combine the time series with. The choices are: " + strings.Join(reducers, ", ") + ". Defaults to REDUCE_NONE.",
+								MarkdownDescription: "The function to combine the time series with. The choices are: `" + strings.Join(reducers, "`, `") + "`. Defaults to `REDUCE_NONE`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(reducers...),
+								},
+							},
+							"alignment_period": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The alignment period. For example: +60s, grafana-auto. Defaults to cloud-monitoring-auto.",
+								MarkdownDescription: "The alignment period. For example: `+60s`, `grafana-auto`. Defaults to `cloud-monitoring-auto`.",
+							},
+							"group_by": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The labels to group the time series by when reducing.",
+							},
+							"alias": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The legend name. For example: {{metric.label.instance_name}}.",
+								MarkdownDescription: "The legend name. For example: `{{metric.label.instance_name}}`.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("builder", queryTypes),
+				},
+				"mql": schema.ListNestedBlock{
+					Description: "The Monitoring Query Language (MQL) query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"project": projectAttribute,
+							"query": schema.StringAttribute{
+								Required:    true,
+								Description: "The MQL query.",
+							},
+							"alias": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The legend name. For example: {{metric.label.instance_name}}.",
+								MarkdownDescription: "The legend name. For example: `{{metric.label.instance_name}}`.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("mql", queryTypes),
+				},
+				"promql": schema.ListNestedBlock{
+					Description: "The PromQL query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"project": projectAttribute,
+							"expr": schema.StringAttribute{
+								Required:    true,
+								Description: "The query expression.",
+							},
+							"step": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The step of the query. For example: 10s.",
+								MarkdownDescription: "The step of the query. For example: `10s`.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("promql", queryTypes),
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of a Google Cloud Monitoring DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(26),
+		},
+	}
+}
+
+func testDataQueryBlock() schema.Block {
+	scenarios := []string{"random_walk", "csv_content", "csv_metric_values", "predictable_pulse", "logs", "raw_frames"}
+
+	return schema.ListNestedBlock{
+		Description: "The TestData query. The query generates the data without a live data source, so the panels can be previewed offline.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"random_walk": schema.ListNestedBlock{
+					Description: "The scenario to generate the random walk time series.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"series_count": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of series to generate. Defaults to 1.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"seed": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The seed of the random generator. The same seed produces the same series.",
+							},
+							"start_value": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The first value of the series.",
+							},
+							"min": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The minimum value of the series.",
+							},
+							"max": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The maximum value of the series.",
+							},
+							"spread": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The maximum step between two consecutive values. Defaults to 1.",
+							},
+							"noise": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The amount of noise added to the values.",
+							},
+							"drop": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The percentage of the values to drop.",
+								Validators: []validator.Float64{
+									float64validator.Between(0, 100),
+								},
+							},
+						},
+					},
+					Validators: requiredBlockValidators("random_walk", scenarios),
+				},
+				"csv_content": schema.ListNestedBlock{
+					Description: "The scenario to return the data frame parsed from the CSV text.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"content": schema.StringAttribute{
+								Required:    true,
+								Description: "The CSV text. The first line is the header.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("csv_content", scenarios),
+				},
+				"csv_metric_values": schema.ListNestedBlock{
+					Description: "The scenario to spread the values evenly across the time range of the dashboard.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"values": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.Float64Type,
+								Description: "The values of the series.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+					Validators: requiredBlockValidators("csv_metric_values", scenarios),
+				},
+				"predictable_pulse": schema.ListNestedBlock{
+					Description: "The scenario to generate the series alternating between the on and off values.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"time_step": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of seconds between the data points. Defaults to 60.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"on_count": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of the data points with the on value in a row. Defaults to 3.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"on_value": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The on value. Defaults to 2.",
+							},
+							"off_count": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of the data points with the off value in a row. Defaults to 3.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"off_value": schema.Float64Attribute{
+								Optional:    true,
+								Description: "The off value. Defaults to 1.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("predictable_pulse", scenarios),
+				},
+				"logs": schema.ListNestedBlock{
+					Description: "The scenario to generate the log lines.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"lines": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of the log lines to generate. Defaults to 10.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"level_column": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to return the level of the log line as a separate column.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("logs", scenarios),
+				},
+				"raw_frames": schema.ListNestedBlock{
+					Description: "The scenario to return the data frames as is.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"content": schema.StringAttribute{
+								Required:            true,
+								Description:         "The JSON array of the data frames. Use jsonencode() to build the frames.",
+								MarkdownDescription: "The JSON array of the data frames. Use `jsonencode()` to build the frames.",
+							},
+						},
+					},
+					Validators: requiredBlockValidators("raw_frames", scenarios),
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"uid": schema.StringAttribute{
+					Description: "The UID of a TestData DataSource to use in this query.",
+					Required:    true,
+				},
+				"hide": schema.BoolAttribute{
+					Description: "Whether to hide query result from the panel or not.",
+					Optional:    true,
+				},
+				"alias": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the generated series.",
+				},
+				"labels": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The labels of the generated series. For example: job=api, instance=$seriesIndex.",
+					MarkdownDescription: "The labels of the generated series. For example: `job=api, instance=$seriesIndex`.",
+				},
+				"ref_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(26),
+		},
+	}
+}
+
+func traceIDQueryBlock(validators []validator.List) schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Looks up a single trace by its ID.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"trace_id": schema.StringAttribute{
+					Required:    true,
+					Description: "The ID of the trace.",
+				},
+			},
+		},
+		Validators: validators,
+	}
+}
+
+// exclusiveBlockValidators allows at most one block named current and forbids its siblings from the given block names.
+func exclusiveBlockValidators(current string, names []string) []validator.List {
+	siblings := make([]path.Expression, 0, len(names)-1)
+
+	for _, name := range names {
+		if name != current {
+			siblings = append(siblings, path.MatchRelative().AtParent().AtName(name))
+		}
+	}
+
+	return []validator.List{
+		listvalidator.SizeAtMost(1),
+		listvalidator.ConflictsWith(siblings...),
+	}
+}
+
+// requiredBlockValidators allows exactly one of the blocks with the given names. The first block also reports
+// when none of the blocks is declared, so the error is not repeated for each block.
+func requiredBlockValidators(current string, names []string) []validator.List {
+	validators := exclusiveBlockValidators(current, names)
+
+	if current == names[0] {
+		blocks := make([]path.Expression, 0, len(names))
+
+		for _, name := range names {
+			blocks = append(blocks, path.MatchRelative().AtParent().AtName(name))
+		}
+
+		validators = append(validators, listvalidator.AtLeastOneOf(blocks...))
+	}
+
+	return validators
+}
+
+func mappingsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The set of rules that translate a field value or range of values into explicit text.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"value": schema.ListNestedBlock{
+					Description: "Match a specific text value.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"value": schema.StringAttribute{
+								Required:    true,
+								Description: "The exact value to match.",
+							},
+							"display_text": schema.StringAttribute{
+								Optional:    true,
+								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The color to use if the condition is met.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
+				"range": schema.ListNestedBlock{
+					Description: "Match a numerical range of values.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"from": schema.Float64Attribute{
+								Required:    true,
+								Description: "The start of the range.",
+							},
+							"to": schema.Float64Attribute{
+								Required:    true,
+								Description: "The end of the range.",
+							},
+							"display_text": schema.StringAttribute{
+								Optional:    true,
+								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The color to use if the condition is met.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
+				"regex": schema.ListNestedBlock{
+					Description: "Match a regular expression with replacement.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"pattern": schema.StringAttribute{
+								Required:    true,
+								Description: "The regular expression to match.",
+							},
+							"display_text": schema.StringAttribute{
+								Optional:    true,
+								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The color to use if the condition is met.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
+				"special": schema.ListNestedBlock{
+					Description: "Match on null, NaN, boolean and empty values.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"match": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The category to match. The choices are: null, nan, null+nan, true, false, empty.",
+								MarkdownDescription: "The category to match. The choices are: `null`, `nan`, `null+nan`, `true`, `false`, `empty`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("null", "nan", "null+nan", "true", "false", "empty"),
+								},
+							},
+							"display_text": schema.StringAttribute{
+								Optional:    true,
+								Description: "Text to display if the condition is met. This field accepts Grafana variables.",
+							},
+							"color": schema.StringAttribute{
+								Optional:    true,
+								Description: "The color to use if the condition is met.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func fieldOverrideBlock(includeThresholdsShowsAs bool) schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The set of rules that override attributes of a field.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"by_name": schema.ListNestedBlock{
+					Description: "Override properties for a field with a specific name.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": fieldBlock(includeThresholdsShowsAs),
+						},
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the field to override attributes for.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(100),
+					},
+				},
+				"by_regex": schema.ListNestedBlock{
+					Description: "Override properties for a field with a matching name.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": fieldBlock(includeThresholdsShowsAs),
+						},
+						Attributes: map[string]schema.Attribute{
+							"regex": schema.StringAttribute{
+								Required:    true,
+								Description: "The regex the field's name should match.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(100),
+					},
+				},
+				"by_type": schema.ListNestedBlock{
+					Description: "Override properties for a field with a specific type.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": fieldBlock(includeThresholdsShowsAs),
+						},
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Required:    true,
+								Description: "The type of the field to override attributes for.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(100),
+					},
+				},
+				"by_query_id": schema.ListNestedBlock{
+					Description: "Override properties for a field returned by a specific query.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": fieldBlock(includeThresholdsShowsAs),
+						},
+						Attributes: map[string]schema.Attribute{
+							"query_id": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the field to override attributes for.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(100),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(10),
+		},
+	}
+}
+
+// attributes
+func idAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+	}
+}
+
+func jsonAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "The Grafana-API-compatible JSON of this panel.",
+	}
+}
+
+func compactJsonAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to use compat JSON encoding or not.",
+	}
+}
+
+func titleAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:    true,
+		Description: "The title of this panel.",
+	}
+}
+
+func descriptionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "The description of this panel.",
+	}
+}
+
+// creators
+
+func createTargets(queries []Query) ([]grafana.Target, *string) {
+	targets := make([]grafana.Target, 0)
+	var minInterval *string
+
+	for _, group := range queries {
+		minInterval = group.MinInterval.ValueStringPointer()
+
+		for _, target := range group.Prometheus {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "prometheus",
+				},
+				RefID:        target.RefId.ValueString(),
+				Hide:         target.Hide.ValueBool(),
+				Expr:         target.Expr.ValueString(),
+				Interval:     target.MinStep.ValueString(),
+				LegendFormat: target.LegendFormat.ValueString(),
+				Instant:      target.Instant.ValueBool(),
+				Format:       target.Format.ValueString(),
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.CloudWatch {
+			zero := 0
+
+			for _, metrics := range target.Metrics {
+				dimensions := make(map[string]string)
+
+				for _, dim := range metrics.Dimensions {
+					dimensions[dim.Name.ValueString()] = dim.Value.ValueString()
+				}
+
+				t := grafana.Target{
+					Datasource: grafana.Datasource{
+						UID:  metrics.UID.ValueString(),
+						Type: "cloudwatch",
+					},
+					RefID:            metrics.RefId.ValueString(),
+					Hide:             metrics.Hide.ValueBool(),
+					QueryMode:        "Metrics",
+					MetricQueryType:  &zero,
+					MetricEditorMode: &zero,
+					Namespace:        metrics.Namespace.ValueString(),
+					MetricName:       metrics.MetricName.ValueString(),
+					Statistic:        metrics.Statistic.ValueString(),
+					Dimensions:       dimensions,
+					MatchExact:       metrics.MatchExact.ValueBool(),
+					Period:           metrics.Period.ValueString(),
+					Region:           metrics.Region.ValueString(),
+					Label:            metrics.Label.ValueString(),
+				}
+
+				targets = append(targets, t)
+			}
+
+			for _, logs := range target.Logs {
+				logGroups := make([]grafana.CloudWatchLogGroup, len(logs.LogGroups))
+
+				for idx, logGroup := range logs.LogGroups {
+					cwLogGroup := grafana.CloudWatchLogGroup{
+						Arn:  logGroup.Arn.ValueString(),
+						Name: logGroup.Name.ValueString(),
+					}
+					logGroups[idx] = cwLogGroup
+				}
+
+				t := grafana.Target{
+					Datasource: grafana.Datasource{
+						UID:  logs.UID.ValueString(),
+						Type: "cloudwatch",
+					},
+					RefID:            logs.RefId.ValueString(),
+					Hide:             logs.Hide.ValueBool(),
+					QueryMode:        "Logs",
+					MetricQueryType:  &zero,
+					MetricEditorMode: &zero,
+					Region:           logs.Region.ValueString(),
+					Expression:       logs.Expression.ValueStringPointer(),
+					LogGroups:        logGroups,
+				}
+
+				targets = append(targets, t)
+			}
+		}
+
+		for _, target := range group.Loki {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "loki",
+				},
+				RefID:        target.RefId.ValueString(),
+				Hide:         target.Hide.ValueBool(),
+				Expr:         target.Expr.ValueString(),
+				QueryType:    "range",
+				LegendFormat: target.LegendFormat.ValueString(),
+				MaxLines:     target.MaxLines.ValueInt64Pointer(),
+				Resolution:   target.Resolution.ValueInt64Pointer(),
+				Direction:    target.Direction.ValueString(),
+			}
+
+			if !target.QueryType.IsNull() {
+				t.QueryType = target.QueryType.ValueString()
+			}
+
+			if !target.Step.IsNull() {
+				t.Step = target.Step.ValueString()
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.Elasticsearch {
+			targets = append(targets, createElasticsearchTarget(target, "elasticsearch"))
+		}
+
+		for _, target := range group.OpenSearch {
+			t := createElasticsearchTarget(target, "grafana-opensearch-datasource")
+			t.QueryType = "lucene"
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.InfluxDB {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "influxdb",
+				},
+				RefID: target.RefId.ValueString(),
+				Hide:  target.Hide.ValueBool(),
+			}
+
+			for _, influxQL := range target.InfluxQL {
+				t.Query = influxQL.Query.ValueString()
+				t.RawQuery = true
+				t.ResultFormat = "time_series"
+				t.Alias = influxQL.Alias.ValueString()
+
+				if !influxQL.ResultFormat.IsNull() {
+					t.ResultFormat = influxQL.ResultFormat.ValueString()
+				}
+			}
+
+			for _, flux := range target.Flux {
+				t.Query = flux.Query.ValueString()
+			}
+
+			for _, sql := range target.SQL {
+				t.RawSQL = sql.Query.ValueString()
+				t.RawQuery = true
+				t.Format = "time_series"
+
+				if !sql.Format.IsNull() {
+					t.Format = sql.Format.ValueString()
+				}
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.Postgres {
+			targets = append(targets, createSQLTarget(target, "grafana-postgresql-datasource"))
+		}
+
+		for _, target := range group.MySQL {
+			targets = append(targets, createSQLTarget(target, "mysql"))
+		}
+
+		for _, target := range group.MSSQL {
+			targets = append(targets, createSQLTarget(target, "mssql"))
+		}
+
+		for _, target := range group.Graphite {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "graphite",
+				},
+				RefID:      target.RefId.ValueString(),
+				Hide:       target.Hide.ValueBool(),
+				Target:     target.Target.ValueString(),
+				TextEditor: target.TextEditor.ValueBool(),
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.Tempo {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "tempo",
+				},
+				RefID: target.RefId.ValueString(),
+				Hide:  target.Hide.ValueBool(),
+			}
+
+			for _, search := range target.Search {
+				t.QueryType = "traceql"
+				t.Query = "{}"
+				t.Limit = search.Limit.ValueInt64Pointer()
+				t.SpansPerSpanSet = search.SpanLimit.ValueInt64Pointer()
+				t.TableType = "traces"
+
+				if !search.Query.IsNull() {
+					t.Query = search.Query.ValueString()
+				}
+
+				if !search.TableType.IsNull() {
+					t.TableType = search.TableType.ValueString()
+				}
+			}
+
+			for _, trace := range target.Trace {
+				t.QueryType = "traceql"
+				t.Query = trace.TraceID.ValueString()
+			}
+
+			for _, serviceGraph := range target.ServiceGraph {
+				t.QueryType = "serviceMap"
+				t.ServiceMapQuery = serviceGraph.Filter.ValueString()
+				t.ServiceMapIncludeNamespace = serviceGraph.IncludeNamespace.ValueBool()
+			}
+
+			for _, metrics := range target.Metrics {
+				t.QueryType = "traceql"
+				t.Query = metrics.Query.ValueString()
+				t.MetricsQueryType = "range"
+
+				if !metrics.Type.IsNull() {
+					t.MetricsQueryType = metrics.Type.ValueString()
+				}
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.Jaeger {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "jaeger",
+				},
+				RefID: target.RefId.ValueString(),
+				Hide:  target.Hide.ValueBool(),
+			}
+
+			for _, search := range target.Search {
+				t.QueryType = "search"
+				t.Service = search.Service.ValueString()
+				t.Operation = search.Operation.ValueString()
+				t.Tags = search.Tags.ValueString()
+				t.MinDuration = search.MinDuration.ValueString()
+				t.MaxDuration = search.MaxDuration.ValueString()
+				t.Limit = search.Limit.ValueInt64Pointer()
+			}
+
+			for _, trace := range target.Trace {
+				t.Query = trace.TraceID.ValueString()
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, target := range group.AzureMonitor {
+			targets = append(targets, createAzureMonitorTarget(target))
+		}
+
+		for _, target := range group.CloudMonitoring {
+			targets = append(targets, createCloudMonitoringTarget(target))
+		}
+
+		for _, target := range group.TestData {
+			targets = append(targets, createTestDataTarget(target))
+		}
+
+		for _, target := range group.Pyroscope {
+			groupBy := make([]string, len(target.GroupBy))
+			for i, label := range target.GroupBy {
+				groupBy[i] = label.ValueString()
+			}
+
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  target.UID.ValueString(),
+					Type: "grafana-pyroscope-datasource",
+				},
+				RefID:         target.RefId.ValueString(),
+				Hide:          target.Hide.ValueBool(),
+				QueryType:     "profile",
+				ProfileTypeID: target.ProfileTypeID.ValueString(),
+				LabelSelector: "{}",
+				GroupBy:       groupBy,
+				MaxNodes:      target.MaxNodes.ValueInt64Pointer(),
+			}
+
+			if !target.QueryType.IsNull() {
+				t.QueryType = target.QueryType.ValueString()
+			}
+
+			if !target.LabelSelector.IsNull() {
+				t.LabelSelector = target.LabelSelector.ValueString()
+			}
+
+			targets = append(targets, t)
+		}
+
+		for _, expression := range group.Expression {
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  "__expr__",
+					Type: "__expr__",
+					Name: "Expression",
+				},
+				RefID: expression.RefId.ValueString(),
+				Hide:  expression.Hide.ValueBool(),
+			}
+
+			for _, math := range expression.Math {
+				t.Type = "math"
+				t.Expression = math.Expression.ValueStringPointer()
+			}
+
+			for _, reduce := range expression.Reduce {
+				t.Type = "reduce"
+				t.Reducer = reduce.Function.ValueStringPointer()
+				t.Expression = reduce.Input.ValueStringPointer()
+
+				if !reduce.Mode.IsNull() {
+					if reduce.Mode.ValueString() == "drop" {
+						t.Settings.Mode = "dropNN"
+					}
+
+					if reduce.Mode.ValueString() == "replace" {
+						t.Settings.Mode = "replaceNN"
+						t.Settings.ReplaceWithValue = reduce.ReplaceWith.ValueFloat64Pointer()
+					}
+				}
+			}
+
+			for _, resample := range expression.Resample {
+				t.Type = "resample"
+				t.Expression = resample.Input.ValueStringPointer()
+				t.Window = resample.To.ValueStringPointer()
+				t.Downsampler = resample.Downsample.ValueStringPointer()
+				t.Upsampler = resample.Upsample.ValueStringPointer()
+			}
+
+			for _, threshold := range expression.Threshold {
+				condition := grafana.ExpressionCondition{
+					Evaluator: grafana.ExpressionConditionEvaluator{
+						Type:   threshold.Evaluator.ValueString(),
+						Params: float64Values(threshold.Params),
+					},
+				}
+
+				for _, hysteresis := range threshold.Hysteresis {
+					condition.UnloadEvaluator = &grafana.ExpressionConditionEvaluator{
+						Type:   recoveryEvaluators[threshold.Evaluator.ValueString()],
+						Params: float64Values(hysteresis.Params),
+					}
+				}
+
+				t.Type = "threshold"
+				t.Expression = threshold.Input.ValueStringPointer()
+				t.Conditions = []grafana.ExpressionCondition{condition}
+			}
+
+			for _, classic := range expression.ClassicConditions {
+				conditions := make([]grafana.ExpressionCondition, len(classic.Conditions))
+
+				for i, c := range classic.Conditions {
+					operator := "and"
+					if !c.Operator.IsNull() {
+						operator = c.Operator.ValueString()
+					}
+
+					conditions[i] = grafana.ExpressionCondition{
+						Type: "query",
+						Query: &grafana.ExpressionConditionQuery{
+							Params: []string{c.Query.ValueString()},
+						},
+						Reducer: &grafana.ExpressionConditionReducer{
+							Type:   c.Reducer.ValueString(),
+							Params: make([]float64, 0),
+						},
+						Evaluator: grafana.ExpressionConditionEvaluator{
+							Type:   c.Evaluator.ValueString(),
+							Params: float64Values(c.Params),
+						},
+						Operator: &grafana.ExpressionConditionOperator{
+							Type: operator,
+						},
+					}
+				}
+
+				t.Type = "classic_conditions"
+				t.Conditions = conditions
+			}
+
+			for _, sql := range expression.SQL {
+				t.Type = "sql"
+				t.Expression = sql.Query.ValueStringPointer()
+			}
+
+			targets = append(targets, t)
+		}
+	}
+
+	resolveGraphiteReferences(targets)
+
+	return targets, minInterval
+}
+
+func createElasticsearchTarget(target ElasticsearchTarget, datasourceType string) grafana.Target {
+	t := grafana.Target{
+		Datasource: grafana.Datasource{
+			UID:  target.UID.ValueString(),
+			Type: datasourceType,
+		},
+		RefID:      target.RefId.ValueString(),
+		Hide:       target.Hide.ValueBool(),
+		Query:      target.Query.ValueString(),
+		Alias:      target.Alias.ValueString(),
+		TimeField:  target.TimeField.ValueString(),
+		Metrics:    make([]grafana.ElasticsearchMetric, 0),
+		BucketAggs: make([]grafana.ElasticsearchBucketAgg, 0),
+	}
+
+	id := 0
+	nextID := func() string {
+		id++
+		return strconv.Itoa(id)
+	}
+
+	documents := false
+
+	for _, metric := range target.Metrics {
+		m := grafana.ElasticsearchMetric{
+			ID:   nextID(),
+			Hide: metric.Hide.ValueBool(),
+		}
+
+		for range metric.Count {
+			m.Type = "count"
+		}
+
+		fieldMetrics := map[string][]ElasticsearchFieldMetric{
+			"avg":         metric.Avg,
+			"sum":         metric.Sum,
+			"min":         metric.Min,
+			"max":         metric.Max,
+			"cardinality": metric.Cardinality,
+		}
+
+		for metricType, fieldMetric := range fieldMetrics {
+			for _, opts := range fieldMetric {
+				m.Type = metricType
+				m.Field = opts.Field.ValueString()
+			}
+		}
+
+		for _, percentiles := range metric.Percentiles {
+			percents := make([]string, len(percentiles.Percents))
+			for i, percent := range percentiles.Percents {
+				percents[i] = strconv.FormatFloat(percent.ValueFloat64(), 'f', -1, 64)
+			}
+
+			m.Type = "percentiles"
+			m.Field = percentiles.Field.ValueString()
+			m.Settings = &grafana.ElasticsearchMetricSettings{Percents: percents}
+		}
+
+		for _, rawData := range metric.RawData {
+			m.Type = "raw_data"
+			m.Settings = &grafana.ElasticsearchMetricSettings{}
+			documents = true
+
+			if !rawData.Size.IsNull() {
+				m.Settings.Size = strconv.FormatInt(rawData.Size.ValueInt64(), 10)
+			}
+		}
+
+		for _, logs := range metric.Logs {
+			m.Type = "logs"
+			m.Settings = &grafana.ElasticsearchMetricSettings{}
+			documents = true
+
+			if !logs.Limit.IsNull() {
+				m.Settings.Limit = strconv.FormatInt(logs.Limit.ValueInt64(), 10)
+			}
+		}
+
+		t.Metrics = append(t.Metrics, m)
+	}
+
+	if len(t.Metrics) == 0 {
+		t.Metrics = append(t.Metrics, grafana.ElasticsearchMetric{ID: nextID(), Type: "count"})
+	}
+
+	for _, bucketAgg := range target.BucketAggregations {
+		b := grafana.ElasticsearchBucketAgg{
+			ID:       nextID(),
+			Settings: &grafana.ElasticsearchBucketAggSettings{},
+		}
+
+		for _, dateHistogram := range bucketAgg.DateHistogram {
+			b.Type = "date_histogram"
+			b.Field = dateHistogram.Field.ValueString()
+			b.Settings.Interval = "auto"
+			b.Settings.MinDocCount = formatOptionalInt64(dateHistogram.MinDocCount)
+			b.Settings.TrimEdges = formatOptionalInt64(dateHistogram.TrimEdges)
+			b.Settings.Offset = dateHistogram.Offset.ValueString()
+
+			if !dateHistogram.Interval.IsNull() {
+				b.Settings.Interval = dateHistogram.Interval.ValueString()
+			}
+		}
+
+		for _, terms := range bucketAgg.Terms {
+			b.Type = "terms"
+			b.Field = terms.Field.ValueString()
+			b.Settings.Size = formatOptionalInt64(terms.Size)
+			b.Settings.Order = terms.Order.ValueString()
+			b.Settings.OrderBy = terms.OrderBy.ValueString()
+			b.Settings.MinDocCount = formatOptionalInt64(terms.MinDocCount)
+		}
+
+		for _, filters := range bucketAgg.Filters {
+			b.Type = "filters"
+
+			for _, filter := range filters.Filters {
+				b.Settings.Filters = append(b.Settings.Filters, grafana.ElasticsearchFilter{
+					Query: filter.Query.ValueString(),
+					Label: filter.Label.ValueString(),
+				})
+			}
+		}
+
+		for _, histogram := range bucketAgg.Histogram {
+			b.Type = "histogram"
+			b.Field = histogram.Field.ValueString()
+			b.Settings.MinDocCount = formatOptionalInt64(histogram.MinDocCount)
+
+			if !histogram.Interval.IsNull() {
+				b.Settings.Interval = strconv.FormatFloat(histogram.Interval.ValueFloat64(), 'f', -1, 64)
+			}
+		}
+
+		for _, geohashGrid := range bucketAgg.GeohashGrid {
+			b.Type = "geohash_grid"
+			b.Field = geohashGrid.Field.ValueString()
+			b.Settings.Precision = formatOptionalInt64(geohashGrid.Precision)
+		}
+
+		t.BucketAggs = append(t.BucketAggs, b)
+	}
+
+	if len(t.BucketAggs) == 0 && !documents {
+		t.BucketAggs = append(t.BucketAggs, grafana.ElasticsearchBucketAgg{
+			ID:       nextID(),
+			Type:     "date_histogram",
+			Settings: &grafana.ElasticsearchBucketAggSettings{Interval: "auto"},
+		})
+	}
+
+	return t
+}
+
+func createSQLTarget(target SQLTarget, datasourceType string) grafana.Target {
+	t := grafana.Target{
+		Datasource: grafana.Datasource{
+			UID:  target.UID.ValueString(),
+			Type: datasourceType,
+		},
+		RefID:      target.RefId.ValueString(),
+		Hide:       target.Hide.ValueBool(),
+		RawSQL:     target.RawSQL.ValueString(),
+		RawQuery:   true,
+		Format:     "table",
+		EditorMode: "code",
+	}
+
+	if !target.Format.IsNull() {
+		t.Format = target.Format.ValueString()
+	}
+
+	if !target.EditorMode.IsNull() {
+		t.EditorMode = target.EditorMode.ValueString()
+	}
+
+	return t
+}
+
+func createAzureMonitorTarget(target AzureMonitorTarget) grafana.Target {
+	t := grafana.Target{
+		Datasource: grafana.Datasource{
+			UID:  target.UID.ValueString(),
+			Type: "grafana-azure-monitor-datasource",
+		},
+		RefID: target.RefId.ValueString(),
+		Hide:  target.Hide.ValueBool(),
+	}
+
+	for _, metrics := range target.Metrics {
+		dimensionFilters := make([]grafana.AzureMonitorDimensionFilter, len(metrics.DimensionFilters))
+
+		for i, filter := range metrics.DimensionFilters {
+			values := make([]string, len(filter.Values))
+			for j, value := range filter.Values {
+				values[j] = value.ValueString()
+			}
+
+			dimensionFilters[i] = grafana.AzureMonitorDimensionFilter{
+				Dimension: filter.Dimension.ValueString(),
+				Operator:  "eq",
+				Filters:   values,
+			}
+
+			if !filter.Operator.IsNull() {
+				dimensionFilters[i].Operator = filter.Operator.ValueString()
+			}
+		}
+
+		t.QueryType = "Azure Monitor"
+		t.Subscription = metrics.Subscription.ValueString()
+		t.AzureMonitor = &grafana.AzureMonitorQuery{
+			Resources: []grafana.AzureMonitorResource{
+				{
+					Subscription:    metrics.Subscription.ValueString(),
+					ResourceGroup:   metrics.ResourceGroup.ValueString(),
+					MetricNamespace: metrics.Namespace.ValueString(),
+					ResourceName:    metrics.ResourceName.ValueString(),
+					Region:          metrics.Region.ValueString(),
+				},
+			},
+			MetricNamespace:  metrics.Namespace.ValueString(),
+			MetricName:       metrics.MetricName.ValueString(),
+			Aggregation:      metrics.Aggregation.ValueString(),
+			TimeGrain:        "auto",
+			DimensionFilters: dimensionFilters,
+			Top:              formatOptionalInt64(metrics.Top),
+			Alias:            metrics.Alias.ValueString(),
+		}
+
+		if !metrics.TimeGrain.IsNull() {
+			t.AzureMonitor.TimeGrain = metrics.TimeGrain.ValueString()
+		}
+	}
+
+	for _, logs := range target.Logs {
+		resources := make([]string, len(logs.Resources))
+		for i, resource := range logs.Resources {
+			resources[i] = resource.ValueString()
+		}
+
+		t.QueryType = "Azure Log Analytics"
+		t.AzureLogAnalytics = &grafana.AzureLogAnalyticsQuery{
+			Query:         logs.Query.ValueString(),
+			Resources:     resources,
+			ResultFormat:  "table",
+			DashboardTime: logs.UseDashboardTime.ValueBool(),
+			TimeColumn:    logs.TimeColumn.ValueString(),
+		}
+
+		if !logs.ResultFormat.IsNull() {
+			t.AzureLogAnalytics.ResultFormat = logs.ResultFormat.ValueString()
+		}
+	}
+
+	for _, resourceGraph := range target.ResourceGraph {
+		subscriptions := make([]string, len(resourceGraph.Subscriptions))
+		for i, subscription := range resourceGraph.Subscriptions {
+			subscriptions[i] = subscription.ValueString()
+		}
+
+		t.QueryType = "Azure Resource Graph"
+		t.Subscriptions = subscriptions
+		t.AzureResourceGraph = &grafana.AzureResourceGraphQuery{
+			Query:        resourceGraph.Query.ValueString(),
+			ResultFormat: "table",
+		}
+	}
+
+	return t
+}
+
+func createCloudMonitoringTarget(target CloudMonitoringTarget) grafana.Target {
+	t := grafana.Target{
+		Datasource: grafana.Datasource{
+			UID:  target.UID.ValueString(),
+			Type: "stackdriver",
+		},
+		RefID: target.RefId.ValueString(),
+		Hide:  target.Hide.ValueBool(),
+	}
+
+	for _, builder := range target.Builder {
+		filters := []string{"metric.type", "=", builder.MetricType.ValueString()}
+
+		for _, filter := range builder.Filters {
+			operator := "="
+			if !filter.Operator.IsNull() {
+				operator = filter.Operator.ValueString()
+			}
+
+			filters = append(filters, "AND", filter.Key.ValueString(), operator, filter.Value.ValueString())
+		}
+
+		groupBy := make([]string, len(builder.GroupBy))
+		for i, label := range builder.GroupBy {
+			groupBy[i] = label.ValueString()
+		}
+
+		t.QueryType = "timeSeriesList"
+		t.AliasBy = builder.Alias.ValueString()
+		t.TimeSeriesList = &grafana.CloudMonitoringTimeSeriesList{
+			ProjectName:        builder.Project.ValueString(),
+			Filters:            filters,
+			PerSeriesAligner:   "ALIGN_MEAN",
+			CrossSeriesReducer: "REDUCE_NONE",
+			AlignmentPeriod:    "cloud-monitoring-auto",
+			GroupBys:           groupBy,
+		}
+
+		if !builder.Aligner.IsNull() {
+			t.TimeSeriesList.PerSeriesAligner = builder.Aligner.ValueString()
+		}
+
+		if !builder.Reducer.IsNull() {
+			t.TimeSeriesList.CrossSeriesReducer = builder.Reducer.ValueString()
+		}
+
+		if !builder.AlignmentPeriod.IsNull() {
+			t.TimeSeriesList.AlignmentPeriod = builder.AlignmentPeriod.ValueString()
+		}
+	}
+
+	for _, mql := range target.MQL {
+		t.QueryType = "timeSeriesQuery"
+		t.AliasBy = mql.Alias.ValueString()
+		t.TimeSeriesQuery = &grafana.CloudMonitoringTimeSeriesQuery{
+			ProjectName: mql.Project.ValueString(),
+			Query:       mql.Query.ValueString(),
+		}
+	}
+
+	for _, promQL := range target.PromQL {
+		t.QueryType = "promQL"
+		t.PromQLQuery = &grafana.CloudMonitoringPromQLQuery{
+			ProjectName: promQL.Project.ValueString(),
+			Expr:        promQL.Expr.ValueString(),
+			Step:        promQL.Step.ValueString(),
+		}
+	}
+
+	return t
+}
+
+func createTestDataTarget(target TestDataTarget) grafana.Target {
+	t := grafana.Target{
+		Datasource: grafana.Datasource{
+			UID:  target.UID.ValueString(),
+			Type: "grafana-testdata-datasource",
+		},
+		RefID:  target.RefId.ValueString(),
+		Hide:   target.Hide.ValueBool(),
+		Alias:  target.Alias.ValueString(),
+		Labels: target.Labels.ValueString(),
+	}
+
+	for _, randomWalk := range target.RandomWalk {
+		t.ScenarioID = "random_walk"
+		t.SeriesCount = randomWalk.SeriesCount.ValueInt64Pointer()
+		t.Seed = randomWalk.Seed.ValueInt64Pointer()
+		t.StartValue = randomWalk.StartValue.ValueFloat64Pointer()
+		t.Min = randomWalk.Min.ValueFloat64Pointer()
+		t.Max = randomWalk.Max.ValueFloat64Pointer()
+		t.Spread = randomWalk.Spread.ValueFloat64Pointer()
+		t.Noise = randomWalk.Noise.ValueFloat64Pointer()
+		t.Drop = randomWalk.Drop.ValueFloat64Pointer()
+	}
+
+	for _, csv := range target.CSVContent {
+		t.ScenarioID = "csv_content"
+		t.CSVContent = csv.Content.ValueString()
 	}
-}
 
-func descriptionAttribute() schema.StringAttribute {
-	return schema.StringAttribute{
-		Optional:    true,
-		Description: "The description of this panel.",
+	for _, csv := range target.CSVMetricValues {
+		values := make([]string, len(csv.Values))
+		for i, value := range csv.Values {
+			values[i] = strconv.FormatFloat(value.ValueFloat64(), 'f', -1, 64)
+		}
+
+		t.ScenarioID = "csv_metric_values"
+		t.StringInput = strings.Join(values, ",")
 	}
-}
 
-// creators
+	for _, pulse := range target.PredictablePulse {
+		t.ScenarioID = "predictable_pulse"
+		t.PulseWave = &grafana.TestDataPulseWave{
+			TimeStep: 60,
+			OnCount:  3,
+			OnValue:  2,
+			OffCount: 3,
+			OffValue: 1,
+		}
 
-func createTargets(queries []Query) ([]grafana.Target, *string) {
-	targets := make([]grafana.Target, 0)
-	var minInterval *string
+		if !pulse.TimeStep.IsNull() {
+			t.PulseWave.TimeStep = pulse.TimeStep.ValueInt64()
+		}
 
-	for _, group := range queries {
-		minInterval = group.MinInterval.ValueStringPointer()
+		if !pulse.OnCount.IsNull() {
+			t.PulseWave.OnCount = pulse.OnCount.ValueInt64()
+		}
 
-		for _, target := range group.Prometheus {
-			t := grafana.Target{
-				Datasource: grafana.Datasource{
-					UID:  target.UID.ValueString(),
-					Type: "prometheus",
-				},
-				RefID:        target.RefId.ValueString(),
-				Hide:         target.Hide.ValueBool(),
-				Expr:         target.Expr.ValueString(),
-				Interval:     target.MinStep.ValueString(),
-				LegendFormat: target.LegendFormat.ValueString(),
-				Instant:      target.Instant.ValueBool(),
-				Format:       target.Format.ValueString(),
-			}
+		if !pulse.OnValue.IsNull() {
+			t.PulseWave.OnValue = pulse.OnValue.ValueFloat64()
+		}
 
-			targets = append(targets, t)
+		if !pulse.OffCount.IsNull() {
+			t.PulseWave.OffCount = pulse.OffCount.ValueInt64()
 		}
 
-		for _, target := range group.CloudWatch {
-			zero := 0
+		if !pulse.OffValue.IsNull() {
+			t.PulseWave.OffValue = pulse.OffValue.ValueFloat64()
+		}
+	}
 
-			for _, metrics := range target.Metrics {
-				dimensions := make(map[string]string)
+	for _, logs := range target.Logs {
+		t.ScenarioID = "logs"
+		t.Lines = logs.Lines.ValueInt64Pointer()
+		t.LevelColumn = logs.LevelColumn.ValueBool()
+	}
 
-				for _, dim := range metrics.Dimensions {
-					dimensions[dim.Name.ValueString()] = dim.Value.ValueString()
-				}
+	for _, frames := range target.RawFrames {
+		t.ScenarioID = "raw_frame"
+		t.RawFrameContent = frames.Content.ValueString()
+	}
 
-				t := grafana.Target{
-					Datasource: grafana.Datasource{
-						UID:  metrics.UID.ValueString(),
-						Type: "cloudwatch",
-					},
-					RefID:            metrics.RefId.ValueString(),
-					Hide:             metrics.Hide.ValueBool(),
-					QueryMode:        "Metrics",
-					MetricQueryType:  &zero,
-					MetricEditorMode: &zero,
-					Namespace:        metrics.Namespace.ValueString(),
-					MetricName:       metrics.MetricName.ValueString(),
-					Statistic:        metrics.Statistic.ValueString(),
-					Dimensions:       dimensions,
-					MatchExact:       metrics.MatchExact.ValueBool(),
-					Period:           metrics.Period.ValueString(),
-					Region:           metrics.Region.ValueString(),
-					Label:            metrics.Label.ValueString(),
-				}
+	return t
+}
 
-				targets = append(targets, t)
-			}
+// recoveryEvaluators maps a threshold evaluator onto the opposite one used by the recovery threshold.
+var recoveryEvaluators = map[string]string{
+	"gt":            "lt",
+	"lt":            "gt",
+	"within_range":  "outside_range",
+	"outside_range": "within_range",
+}
 
-			for _, logs := range target.Logs {
-				logGroups := make([]grafana.CloudWatchLogGroup, len(logs.LogGroups))
+func float64Values(values []types.Float64) []float64 {
+	result := make([]float64, len(values))
+	for i, value := range values {
+		result[i] = value.ValueFloat64()
+	}
 
-				for idx, logGroup := range logs.LogGroups {
-					cwLogGroup := grafana.CloudWatchLogGroup{
-						Arn:  logGroup.Arn.ValueString(),
-						Name: logGroup.Name.ValueString(),
-					}
-					logGroups[idx] = cwLogGroup
-				}
+	return result
+}
 
-				t := grafana.Target{
-					Datasource: grafana.Datasource{
-						UID:  logs.UID.ValueString(),
-						Type: "cloudwatch",
-					},
-					RefID:            logs.RefId.ValueString(),
-					Hide:             logs.Hide.ValueBool(),
-					QueryMode:        "Logs",
-					MetricQueryType:  &zero,
-					MetricEditorMode: &zero,
-					Region:           logs.Region.ValueString(),
-					Expression:       logs.Expression.ValueStringPointer(),
-					LogGroups:        logGroups,
-				}
+func formatOptionalInt64(value types.Int64) string {
+	if value.IsNull() {
+		return ""
+	}
 
-				targets = append(targets, t)
-			}
+	return strconv.FormatInt(value.ValueInt64(), 10)
+}
+
+var graphiteReferenceRegex = regexp.MustCompile(`#([A-Z])`)
+
+// resolveGraphiteReferences expands the references to other Graphite queries (such as #A) the same way Grafana does:
+// the expanded expression is stored in targetFull, and the referenced query counts the queries referencing it in refCount.
+func resolveGraphiteReferences(targets []grafana.Target) {
+	graphiteTargets := make(map[string]*grafana.Target)
+
+	for i := range targets {
+		if ds, ok := targets[i].Datasource.(grafana.Datasource); ok && ds.Type == "graphite" && targets[i].RefID != "" {
+			graphiteTargets[targets[i].RefID] = &targets[i]
 		}
+	}
 
-		for _, expression := range group.Expression {
-			t := grafana.Target{
-				Datasource: grafana.Datasource{
-					UID:  "__expr__",
-					Type: "__expr__",
-					Name: "Expression",
-				},
-				RefID: expression.RefId.ValueString(),
-				Hide:  expression.Hide.ValueBool(),
-			}
+	var expand func(target string, visited map[string]bool) string
+	expand = func(target string, visited map[string]bool) string {
+		return graphiteReferenceRegex.ReplaceAllStringFunc(target, func(match string) string {
+			refID := match[1:]
+			referenced, ok := graphiteTargets[refID]
 
-			for _, math := range expression.Math {
-				t.Type = "math"
-				t.Expression = math.Expression.ValueStringPointer()
+			if !ok || visited[refID] {
+				return match
 			}
 
-			for _, reduce := range expression.Reduce {
-				t.Type = "reduce"
-				t.Reducer = reduce.Function.ValueStringPointer()
-				t.Expression = reduce.Input.ValueStringPointer()
+			visited[refID] = true
+			defer delete(visited, refID)
 
-				if !reduce.Mode.IsNull() {
-					if reduce.Mode.ValueString() == "drop" {
-						t.Settings.Mode = "dropNN"
-					}
+			return expand(referenced.Target, visited)
+		})
+	}
 
-					if reduce.Mode.ValueString() == "replace" {
-						t.Settings.Mode = "replaceNN"
-						t.Settings.ReplaceWithValue = reduce.ReplaceWith.ValueFloat64Pointer()
-					}
-				}
-			}
+	for i := range targets {
+		if ds, ok := targets[i].Datasource.(grafana.Datasource); !ok || ds.Type != "graphite" {
+			continue
+		}
 
-			for _, resample := range expression.Resample {
-				t.Type = "resample"
-				t.Expression = resample.Input.ValueStringPointer()
-				t.Window = resample.To.ValueStringPointer()
-				t.Downsampler = resample.Downsample.ValueStringPointer()
-				t.Upsampler = resample.Upsample.ValueStringPointer()
+		references := make(map[*grafana.Target]bool)
+
+		for _, match := range graphiteReferenceRegex.FindAllStringSubmatch(targets[i].Target, -1) {
+			if referenced, ok := graphiteTargets[match[1]]; ok && referenced != &targets[i] && !references[referenced] {
+				references[referenced] = true
+				referenced.RefCount++
 			}
+		}
 
-			targets = append(targets, t)
+		if graphiteReferenceRegex.MatchString(targets[i].Target) {
+			targets[i].TargetFull = expand(targets[i].Target, map[string]bool{targets[i].RefID: true})
 		}
 	}
-
-	return targets, minInterval
 }
 
 type ValueMappingResult struct {
@@ -1635,6 +4310,36 @@ func updateThresholds(thresholds *grafana.Thresholds, thresholdOptions []Thresho
 	}
 }
 
+func updateAxis(custom *grafana.FieldConfigCustom, opts []AxisOptions) {
+	for _, axis := range opts {
+		if !axis.Label.IsNull() {
+			custom.AxisLabel = axis.Label.ValueString()
+		}
+
+		if !axis.Placement.IsNull() {
+			custom.AxisPlacement = axis.Placement.ValueString()
+		}
+
+		if !axis.SoftMin.IsNull() {
+			custom.AxisSoftMin = axis.SoftMin.ValueFloat64Pointer()
+		}
+
+		if !axis.SoftMax.IsNull() {
+			custom.AxisSoftMax = axis.SoftMax.ValueFloat64Pointer()
+		}
+
+		for _, scale := range axis.Scale {
+			if !scale.Type.IsNull() {
+				custom.ScaleDistribution.Type = scale.Type.ValueString()
+			}
+
+			if !scale.Log.IsNull() {
+				custom.ScaleDistribution.Log = int(scale.Log.ValueInt64())
+			}
+		}
+	}
+}
+
 func updateTextSize(options *grafana.TextSize, opts []TextSizeOptions) {
 	for _, textSize := range opts {
 		options.TitleSize = textSize.Title.ValueInt64Pointer()
@@ -1674,3 +4379,291 @@ func hashcode(s []byte) int {
 	// v == MinInt
 	return 0
 }
+
+var (
+	sqlTokenRegex       = regexp.MustCompile("\"[^\"]*\"|`[^`]*`|[A-Za-z_][A-Za-z0-9_]*|\\S")
+	sqlIdentifierRegex  = regexp.MustCompile("^(?:\"[^\"]*\"|`[^`]*`|[A-Za-z_][A-Za-z0-9_]*)$")
+	sqlWordRegex        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	sqlCommonTableRegex = regexp.MustCompile(`(?i)(?:\bWITH\s+(?:RECURSIVE\s+)?|,\s*)([A-Za-z_][A-Za-z0-9_]*)\s+AS\s*\(`)
+	sqlLiteralRegex     = regexp.MustCompile(`'(?:[^']|'')*'|--[^\n]*|/\*[\s\S]*?\*/`)
+)
+
+// sqlKeywords are the keywords that may precede a parenthesis that is not a function call, such as a subquery.
+var sqlKeywords = map[string]bool{
+	"ALL": true, "AND": true, "ANY": true, "AS": true, "BY": true, "ELSE": true, "EXCEPT": true, "EXISTS": true,
+	"FROM": true, "HAVING": true, "IN": true, "INTERSECT": true, "JOIN": true, "LATERAL": true, "NOT": true, "ON": true,
+	"OR": true, "SELECT": true, "SOME": true, "THEN": true, "UNION": true, "USING": true, "WHEN": true, "WHERE": true,
+}
+
+// sqlClauseKeywords are the keywords that end the list of tables of the FROM clause.
+var sqlClauseKeywords = map[string]bool{
+	"EXCEPT": true, "GROUP": true, "HAVING": true, "INTERSECT": true, "LIMIT": true, "OFFSET": true, "ON": true,
+	"ORDER": true, "SELECT": true, "UNION": true, "USING": true, "WHERE": true, "WINDOW": true,
+}
+
+// sqlTableReferences returns the tables the SQL query selects from or joins, except the common table expressions.
+// The FROM keyword inside function calls, such as EXTRACT(HOUR FROM time), does not reference a table.
+func sqlTableReferences(query string) []string {
+	query = sqlLiteralRegex.ReplaceAllString(query, " ")
+
+	commonTables := make(map[string]bool)
+	for _, match := range sqlCommonTableRegex.FindAllStringSubmatch(query, -1) {
+		commonTables[match[1]] = true
+	}
+
+	// scope is the state of the query between a pair of parentheses.
+	type scope struct {
+		function bool
+		tables   bool
+		table    bool
+	}
+
+	scopes := []*scope{{}}
+	previous := ""
+
+	var tables []string
+	for _, token := range sqlTokenRegex.FindAllString(query, -1) {
+		current := scopes[len(scopes)-1]
+		keyword := strings.ToUpper(token)
+
+		switch {
+		case token == "(":
+			current.table = false
+			scopes = append(scopes, &scope{function: sqlWordRegex.MatchString(previous) && !sqlKeywords[strings.ToUpper(previous)]})
+		case token == ")":
+			if len(scopes) > 1 {
+				scopes = scopes[:len(scopes)-1]
+			}
+		case current.function:
+		case keyword == "FROM" || keyword == "JOIN":
+			current.tables = true
+			current.table = true
+		case sqlClauseKeywords[keyword]:
+			current.tables = false
+			current.table = false
+		case token == ",":
+			current.table = current.tables
+		case current.table && keyword != "LATERAL" && sqlIdentifierRegex.MatchString(token):
+			current.table = false
+			table := strings.Trim(token, "\"`")
+			if !commonTables[table] {
+				tables = append(tables, table)
+			}
+		}
+
+		previous = token
+	}
+
+	return tables
+}
+
+// elasticsearchBucketAggregationValidator checks that the bucket aggregations are not used
+// with the raw_data and logs metrics, which return the documents as they are.
+type elasticsearchBucketAggregationValidator struct{}
+
+func (v elasticsearchBucketAggregationValidator) Description(_ context.Context) string {
+	return "Bucket aggregations cannot be used with the raw_data and logs metrics."
+}
+
+func (v elasticsearchBucketAggregationValidator) MarkdownDescription(_ context.Context) string {
+	return "Bucket aggregations cannot be used with the `raw_data` and `logs` metrics."
+}
+
+func (v elasticsearchBucketAggregationValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || len(req.ConfigValue.Elements()) == 0 {
+		return
+	}
+
+	var metrics types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("metric"), &metrics)...)
+	if resp.Diagnostics.HasError() || metrics.IsUnknown() {
+		return
+	}
+
+	for _, element := range metrics.Elements() {
+		metric, ok := element.(basetypes.ObjectValue)
+		if !ok || metric.IsUnknown() {
+			continue
+		}
+
+		for _, name := range []string{"raw_data", "logs"} {
+			if documents, ok := metric.Attributes()[name].(basetypes.ListValue); ok && len(documents.Elements()) > 0 {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Bucket aggregations cannot be used with the %s metric.", name),
+				)
+				return
+			}
+		}
+	}
+}
+
+// evaluatorParams is the number of values each evaluator compares with.
+var evaluatorParams = map[string]int{
+	"gt":            1,
+	"lt":            1,
+	"within_range":  2,
+	"outside_range": 2,
+	"no_value":      0,
+}
+
+// evaluatorParamsValidator checks that the number of params matches the evaluator.
+// The evaluator is the sibling attribute of the block found parents levels above the params.
+type evaluatorParamsValidator struct {
+	parents int
+}
+
+func (v evaluatorParamsValidator) Description(_ context.Context) string {
+	return "One value for gt and lt, two values for within_range and outside_range, no values for no_value."
+}
+
+func (v evaluatorParamsValidator) MarkdownDescription(_ context.Context) string {
+	return "One value for `gt` and `lt`, two values for `within_range` and `outside_range`, no values for `no_value`."
+}
+
+func (v evaluatorParamsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	evaluatorPath := req.Path
+	for i := 0; i < v.parents; i++ {
+		evaluatorPath = evaluatorPath.ParentPath()
+	}
+
+	var evaluator types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, evaluatorPath.AtName("evaluator"), &evaluator)...)
+	if resp.Diagnostics.HasError() || evaluator.IsNull() || evaluator.IsUnknown() {
+		return
+	}
+
+	expected, ok := evaluatorParams[evaluator.ValueString()]
+	if !ok {
+		return
+	}
+
+	if actual := len(req.ConfigValue.Elements()); actual != expected {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Evaluator Params",
+			fmt.Sprintf("The evaluator %q requires %d value(s), got: %d.", evaluator.ValueString(), expected, actual),
+		)
+	}
+}
+
+// sqlExpressionReferencesValidator checks that the tables of a SQL expression
+// are the refIDs of the queries in the same queries block.
+type sqlExpressionReferencesValidator struct{}
+
+func (v sqlExpressionReferencesValidator) Description(_ context.Context) string {
+	return "The tables of the SQL query must be the refIDs of the queries in the same queries block."
+}
+
+func (v sqlExpressionReferencesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sqlExpressionReferencesValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	refIDs := make(map[string]bool)
+	if !collectRefIDs(ctx, req.ConfigValue, refIDs) {
+		return
+	}
+
+	expressions, ok := req.ConfigValue.Attributes()["expression"].(basetypes.ListValue)
+	if !ok || expressions.IsNull() || expressions.IsUnknown() {
+		return
+	}
+
+	for i, expression := range expressions.Elements() {
+		expression, ok := expression.(basetypes.ObjectValue)
+		if !ok || expression.IsNull() || expression.IsUnknown() {
+			continue
+		}
+
+		sqls, ok := expression.Attributes()["sql"].(basetypes.ListValue)
+		if !ok || sqls.IsNull() || sqls.IsUnknown() {
+			continue
+		}
+
+		for j, sql := range sqls.Elements() {
+			sql, ok := sql.(basetypes.ObjectValue)
+			if !ok || sql.IsNull() || sql.IsUnknown() {
+				continue
+			}
+
+			query, ok := sql.Attributes()["query"].(basetypes.StringValue)
+			if !ok || query.IsNull() || query.IsUnknown() {
+				continue
+			}
+
+			queryPath := req.Path.AtName("expression").AtListIndex(i).AtName("sql").AtListIndex(j).AtName("query")
+			for _, table := range sqlTableReferences(query.ValueString()) {
+				if !refIDs[table] {
+					resp.Diagnostics.AddAttributeError(
+						queryPath,
+						"Unknown Query Reference",
+						fmt.Sprintf("The SQL expression references the table %q, but there is no query with ref_id = %q in the same queries block.", table, table),
+					)
+				}
+			}
+		}
+	}
+}
+
+// collectRefIDs adds the ref_id values found in the nested blocks of the value to refIDs.
+// It returns false when any of the ref_id values is not known yet.
+func collectRefIDs(ctx context.Context, value attr.Value, refIDs map[string]bool) bool {
+	if value.IsUnknown() {
+		return !hasRefID(value.Type(ctx))
+	}
+
+	switch v := value.(type) {
+	case basetypes.ListValue:
+		for _, element := range v.Elements() {
+			if !collectRefIDs(ctx, element, refIDs) {
+				return false
+			}
+		}
+	case basetypes.ObjectValue:
+		for name, attribute := range v.Attributes() {
+			if refID, ok := attribute.(basetypes.StringValue); ok && name == "ref_id" {
+				if refID.IsUnknown() {
+					return false
+				}
+
+				if !refID.IsNull() {
+					refIDs[refID.ValueString()] = true
+				}
+
+				continue
+			}
+
+			if !collectRefIDs(ctx, attribute, refIDs) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// hasRefID reports whether the values of the type may contain a ref_id attribute.
+func hasRefID(t attr.Type) bool {
+	switch t := t.(type) {
+	case basetypes.ListType:
+		return hasRefID(t.ElemType)
+	case basetypes.ObjectType:
+		for name, attributeType := range t.AttrTypes {
+			if name == "ref_id" || hasRefID(attributeType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
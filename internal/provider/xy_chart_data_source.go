@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strconv"
+
+	"github.com/gdashboard/terraform-provider-gdashboard/internal/provider/grafana"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &XYChartDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &XYChartDataSource{}
+
+func NewXYChartDataSource() datasource.DataSource {
+	return &XYChartDataSource{}
+}
+
+// XYChartDataSource defines the data source implementation.
+type XYChartDataSource struct {
+	CompactJson bool
+	Defaults    XYChartDefaults
+}
+
+type XYChartDefaults struct {
+	Legend  TimeseriesLegendDefault
+	Tooltip TimeseriesTooltipDefaults
+	Field   FieldDefaults
+	Axis    AxisDefaults
+	Graph   XYChartGraphDefaults
+}
+
+type XYChartGraphDefaults struct {
+	SeriesMapping string
+	XField        string
+	Show          string
+	PointSize     int
+	PointSizeMin  int
+	PointSizeMax  int
+	LineWidth     int
+}
+
+// XYChartDataSourceModel describes the data source data model.
+type XYChartDataSourceModel struct {
+	Id              types.String               `tfsdk:"id"`
+	Json            types.String               `tfsdk:"json"`
+	CompactJson     types.Bool                 `tfsdk:"compact_json"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Queries         []Query                    `tfsdk:"queries"`
+	Legend          []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field           []FieldOptions             `tfsdk:"field"`
+	Axis            []AxisOptions              `tfsdk:"axis"`
+	Graph           []XYChartGraphOptions      `tfsdk:"graph"`
+	Series          []XYChartSeriesOptions     `tfsdk:"series"`
+	Overrides       []FieldOverrideOptions     `tfsdk:"overrides"`
+	Transformations []Transformations          `tfsdk:"transform"`
+}
+
+type XYChartGraphOptions struct {
+	SeriesMapping types.String `tfsdk:"series_mapping"`
+	XField        types.String `tfsdk:"x_field"`
+	Show          types.String `tfsdk:"show"`
+	PointSize     types.Int64  `tfsdk:"point_size"`
+	PointSizeMin  types.Int64  `tfsdk:"point_size_min"`
+	PointSizeMax  types.Int64  `tfsdk:"point_size_max"`
+	LineWidth     types.Int64  `tfsdk:"line_width"`
+}
+
+type XYChartSeriesOptions struct {
+	Name       types.String `tfsdk:"name"`
+	XField     types.String `tfsdk:"x_field"`
+	YField     types.String `tfsdk:"y_field"`
+	SizeField  types.String `tfsdk:"size_field"`
+	ColorField types.String `tfsdk:"color_field"`
+	Color      types.String `tfsdk:"color"`
+}
+
+func (d *XYChartDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_xy_chart"
+}
+
+func xyChartGraphBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The visualization options.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"series_mapping": schema.StringAttribute{
+					Optional: true,
+					Description: "Choose how the series are mapped to the axes. The choices are: auto, manual. " +
+						"In the auto mode, the x-axis field is used to plot every other numeric field. " +
+						"In the manual mode, the series are defined by the series blocks.",
+					MarkdownDescription: "Choose how the series are mapped to the axes. The choices are: `auto`, `manual`. " +
+						"In the `auto` mode, the x-axis field is used to plot every other numeric field. " +
+						"In the `manual` mode, the series are defined by the `series` blocks.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "manual"),
+					},
+				},
+				"x_field": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field to use for the x-axis in the auto mode. By default, the first numeric field is used.",
+				},
+				"show": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to display the data. The choices are: points, lines, both.",
+					MarkdownDescription: "Choose how to display the data. The choices are: `points`, `lines`, `both`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("points", "lines", "both"),
+					},
+				},
+				"point_size": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The size of the data point. Must be between 1 and 100 (inclusive).",
+					MarkdownDescription: "The size of the data point. Must be between `1` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 100),
+					},
+				},
+				"point_size_min": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum size of the data point when the size is bound to a field. Must be between 1 and 100 (inclusive).",
+					MarkdownDescription: "The minimum size of the data point when the size is bound to a field. Must be between `1` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 100),
+					},
+				},
+				"point_size_max": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum size of the data point when the size is bound to a field. Must be between 1 and 100 (inclusive).",
+					MarkdownDescription: "The maximum size of the data point when the size is bound to a field. Must be between `1` and `100` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 100),
+					},
+				},
+				"line_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The width of the line. Must be between 0 and 10 (inclusive).",
+					MarkdownDescription: "The width of the line. Must be between `0` and `10` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 10),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func xyChartSeriesBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description:         "The series to plot. Used only when the series mapping is manual.",
+		MarkdownDescription: "The series to plot. Used only when the `series_mapping` is `manual`.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the series.",
+				},
+				"x_field": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the field to use for the x-axis.",
+				},
+				"y_field": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the field to use for the y-axis.",
+				},
+				"size_field": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field to use for the size of the data points.",
+				},
+				"color_field": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the field to use for the color of the data points.",
+				},
+				"color": schema.StringAttribute{
+					Optional:    true,
+					Description: "The fixed color of the data points.",
+				},
+			},
+		},
+	}
+}
+
+func (d *XYChartDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "XY chart panel data source.",
+		MarkdownDescription: "XY chart panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/xy-chart/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"queries":   queryBlock(),
+			"legend":    timeseriesLegendBlock(),
+			"tooltip":   timeseriesTooltipBlock(),
+			"field":     fieldBlock(false),
+			"axis":      axisBlock(),
+			"graph":     xyChartGraphBlock(),
+			"series":    xyChartSeriesBlock(),
+			"overrides": fieldOverrideBlock(false),
+			"transform": transformationsBlock(),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id":           idAttribute(),
+			"json":         jsonAttribute(),
+			"compact_json": compactJsonAttribute(),
+			"title":        titleAttribute(),
+			"description":  descriptionAttribute(),
+		},
+	}
+}
+
+func (d *XYChartDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var graph types.List
+	var series types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("graph"), &graph)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("series"), &series)...)
+
+	if resp.Diagnostics.HasError() || graph.IsUnknown() || series.IsUnknown() {
+		return
+	}
+
+	var graphOptions []XYChartGraphOptions
+	resp.Diagnostics.Append(graph.ElementsAs(ctx, &graphOptions, false)...)
+
+	for i, options := range graphOptions {
+		if options.SeriesMapping.IsNull() || options.SeriesMapping.IsUnknown() {
+			continue
+		}
+
+		validateXYChartSeriesMapping(
+			options.SeriesMapping.ValueString(),
+			len(series.Elements()),
+			path.Root("graph").AtListIndex(i).AtName("series_mapping"),
+			&resp.Diagnostics,
+		)
+	}
+}
+
+// validateXYChartSeriesMapping checks that the series blocks are declared if and only if the series are mapped manually.
+func validateXYChartSeriesMapping(seriesMapping string, seriesCount int, attributePath path.Path, diags *diag.Diagnostics) {
+	if seriesMapping == "manual" && seriesCount == 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Missing Series",
+			"The series mapping is \"manual\", but there are no series blocks. Declare at least one series block.",
+		)
+	}
+
+	if seriesMapping != "manual" && seriesCount > 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Unused Series",
+			fmt.Sprintf("The series blocks are used only when the series mapping is \"manual\", but the series mapping is %q.", seriesMapping),
+		)
+	}
+}
+
+func (d *XYChartDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	defaults, ok := req.ProviderData.(Defaults)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Defaults, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+
+	d.CompactJson = defaults.CompactJson
+	d.Defaults = defaults.XYChart
+}
+
+func (d *XYChartDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data XYChartDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, minInterval := createTargets(data.Queries)
+	transformations := createTransformations(data.Transformations)
+
+	legendOptions := grafana.VizLegendOptions{
+		Calcs:       d.Defaults.Legend.Calculations,
+		DisplayMode: d.Defaults.Legend.DisplayMode,
+		Placement:   d.Defaults.Legend.Placement,
+	}
+
+	tooltipOptions := grafana.TimeseriesTooltipOptions{
+		Mode: d.Defaults.Tooltip.Mode,
+	}
+
+	for _, legend := range data.Legend {
+		if len(legend.Calculations) > 0 {
+			calculations := make([]string, len(legend.Calculations))
+			for i, calc := range legend.Calculations {
+				calculations[i] = calc.ValueString()
+			}
+
+			legendOptions.Calcs = calculations
+		}
+
+		if !legend.DisplayMode.IsNull() {
+			legendOptions.DisplayMode = legend.DisplayMode.ValueString()
+		}
+
+		if !legend.Placement.IsNull() {
+			legendOptions.Placement = legend.Placement.ValueString()
+		}
+	}
+
+	legendOptions.ShowLegend = legendOptions.DisplayMode != "hidden"
+
+	for _, tooltip := range data.Tooltip {
+		tooltipOptions.Mode = tooltip.Mode.ValueString()
+	}
+
+	graphOptions := d.Defaults.Graph
+
+	for _, graph := range data.Graph {
+		updateXYChartGraphDefaults(&graphOptions, graph)
+	}
+
+	validateXYChartSeriesMapping(graphOptions.SeriesMapping, len(data.Series), path.Root("series"), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pointSize := grafana.XYChartPointSize{
+		Fixed: graphOptions.PointSize,
+		Min:   graphOptions.PointSizeMin,
+		Max:   graphOptions.PointSizeMax,
+	}
+
+	options := grafana.XYChartOptions{
+		SeriesMapping: graphOptions.SeriesMapping,
+		Dims: grafana.XYChartDims{
+			Frame: 0,
+		},
+		Legend:  legendOptions,
+		Tooltip: tooltipOptions,
+	}
+
+	if graphOptions.SeriesMapping == "manual" {
+		options.Series = make([]grafana.XYChartSeries, len(data.Series))
+
+		for i, series := range data.Series {
+			s := grafana.XYChartSeries{
+				Name:      series.Name.ValueString(),
+				X:         series.XField.ValueString(),
+				Y:         series.YField.ValueString(),
+				PointSize: pointSize,
+				PointColor: grafana.XYChartPointColor{
+					Field: series.ColorField.ValueString(),
+					Fixed: series.Color.ValueString(),
+				},
+			}
+
+			s.PointSize.Field = series.SizeField.ValueString()
+
+			options.Series[i] = s
+		}
+	} else {
+		options.Dims.X = graphOptions.XField
+		options.Series = []grafana.XYChartSeries{
+			{
+				PointSize: pointSize,
+			},
+		}
+	}
+
+	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
+
+	fieldConfig.Custom = grafana.FieldConfigCustom{
+		Show:      xyChartShow(graphOptions.Show),
+		LineWidth: graphOptions.LineWidth,
+		// axis
+		AxisLabel:     d.Defaults.Axis.Label,
+		AxisPlacement: d.Defaults.Axis.Placement,
+		AxisSoftMin:   d.Defaults.Axis.SoftMin,
+		AxisSoftMax:   d.Defaults.Axis.SoftMax,
+	}
+
+	fieldConfig.Custom.LineStyle.Fill = "solid"
+	fieldConfig.Custom.ScaleDistribution.Type = d.Defaults.Axis.Scale.Type
+	fieldConfig.Custom.ScaleDistribution.Log = d.Defaults.Axis.Scale.Log
+
+	updateAxis(&fieldConfig.Custom, data.Axis)
+
+	panel := &grafana.Panel{
+		CommonPanel: grafana.CommonPanel{
+			OfType:          grafana.XYChartType,
+			Title:           data.Title.ValueString(),
+			Type:            "xychart",
+			Span:            12,
+			IsNew:           true,
+			Transformations: transformations,
+			Interval:        minInterval,
+		},
+		XYChartPanel: &grafana.XYChartPanel{
+			Targets: targets,
+			Options: options,
+			FieldConfig: grafana.FieldConfig{
+				Defaults:  fieldConfig,
+				Overrides: createOverrides(data.Overrides),
+			},
+		},
+	}
+
+	if !data.Description.IsNull() {
+		panel.CommonPanel.Description = data.Description.ValueStringPointer()
+	}
+
+	var jsonData []byte
+	var err error
+
+	if data.CompactJson.ValueBool() || d.CompactJson {
+		jsonData, err = json.Marshal(panel)
+	} else {
+		jsonData, err = json.MarshalIndent(panel, "", "  ")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func updateXYChartGraphDefaults(defaults *XYChartGraphDefaults, graph XYChartGraphOptions) {
+	if !graph.SeriesMapping.IsNull() {
+		defaults.SeriesMapping = graph.SeriesMapping.ValueString()
+	}
+
+	if !graph.XField.IsNull() {
+		defaults.XField = graph.XField.ValueString()
+	}
+
+	if !graph.Show.IsNull() {
+		defaults.Show = graph.Show.ValueString()
+	}
+
+	if !graph.PointSize.IsNull() {
+		defaults.PointSize = int(graph.PointSize.ValueInt64())
+	}
+
+	if !graph.PointSizeMin.IsNull() {
+		defaults.PointSizeMin = int(graph.PointSizeMin.ValueInt64())
+	}
+
+	if !graph.PointSizeMax.IsNull() {
+		defaults.PointSizeMax = int(graph.PointSizeMax.ValueInt64())
+	}
+
+	if !graph.LineWidth.IsNull() {
+		defaults.LineWidth = int(graph.LineWidth.ValueInt64())
+	}
+}
+
+func xyChartShow(show string) string {
+	if show == "both" {
+		return "points+lines"
+	}
+
+	return show
+}
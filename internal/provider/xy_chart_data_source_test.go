@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccXYChartDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config:      testAccXYChartDataSourceManualWithoutSeriesConfig,
+				ExpectError: regexp.MustCompile("The series mapping is \"manual\", but there are no series blocks"),
+			},
+			{
+				Config:      testAccXYChartDataSourceAutoWithSeriesConfig,
+				ExpectError: regexp.MustCompile("The series blocks are used only when the series mapping is \"manual\""),
+			},
+			{
+				Config: testAccXYChartDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "json", testAccXYChartDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccXYChartDataSourceProviderCustomDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "json", testAccXYChartDataSourceProviderCustomDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccXYChartDataSourceProviderDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_xy_chart.test", "json", testAccXYChartDataSourceProviderDefaultsConfigExpectedJson),
+				),
+			},
+		},
+	})
+}
+
+const testAccXYChartDataSourceManualWithoutSeriesConfig = `
+data "gdashboard_xy_chart" "test" {
+  title = "Test"
+
+  graph {
+    series_mapping = "manual"
+  }
+}
+`
+
+const testAccXYChartDataSourceAutoWithSeriesConfig = `
+data "gdashboard_xy_chart" "test" {
+  title = "Test"
+
+  series {
+    x_field = "cpu"
+    y_field = "throughput"
+  }
+}
+`
+
+const testAccXYChartDataSourceConfig = `
+data "gdashboard_xy_chart" "test" {
+  title       = "Test"
+  description = "XY chart description"
+
+  legend {
+    display_mode = "table"
+    placement    = "right"
+  }
+
+  tooltip {
+    mode = "multi"
+  }
+
+  field {
+    unit = "percent"
+  }
+
+  axis {
+    label     = "CPU"
+    placement = "left"
+  }
+
+  graph {
+    series_mapping = "manual"
+    show           = "both"
+    point_size     = 8
+    point_size_min = 2
+    point_size_max = 40
+    line_width     = 2
+  }
+
+  series {
+    name    = "CPU vs RPS"
+    x_field = "rps"
+    y_field = "cpu"
+    color   = "red"
+  }
+
+  series {
+    x_field     = "rps"
+    y_field     = "memory"
+    size_field  = "pods"
+    color_field = "latency"
+  }
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "sum(rate(http_requests_total[$__rate_interval]))"
+      ref_id = "rps"
+    }
+  }
+}
+`
+
+const testAccXYChartDataSourceConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "description": "XY chart description",
+  "transparent": false,
+  "type": "xychart",
+  "targets": [
+    {
+      "refId": "rps",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum(rate(http_requests_total[$__rate_interval]))"
+    }
+  ],
+  "options": {
+    "seriesMapping": "manual",
+    "dims": {
+      "frame": 0
+    },
+    "series": [
+      {
+        "name": "CPU vs RPS",
+        "x": "rps",
+        "y": "cpu",
+        "pointColor": {
+          "fixed": "red"
+        },
+        "pointSize": {
+          "fixed": 8,
+          "min": 2,
+          "max": 40
+        }
+      },
+      {
+        "x": "rps",
+        "y": "memory",
+        "pointColor": {
+          "field": "latency"
+        },
+        "pointSize": {
+          "field": "pods",
+          "fixed": 8,
+          "min": 2,
+          "max": 40
+        }
+      }
+    ],
+    "legend": {
+      "calcs": [],
+      "displayMode": "table",
+      "placement": "right",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "multi"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "percent",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisLabel": "CPU",
+        "axisPlacement": "left",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 2,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "show": "points+lines",
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccXYChartDataSourceProviderCustomDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    xy_chart {
+      legend {
+        display_mode = "hidden"
+      }
+
+      tooltip {
+        mode = "hidden"
+      }
+
+      field {
+        unit = "short"
+      }
+
+      axis {
+        scale {
+          type = "log"
+          log  = 2
+        }
+      }
+
+      graph {
+        x_field    = "throughput"
+        show       = "lines"
+        point_size = 3
+        line_width = 3
+      }
+    }
+  }
+}
+
+data "gdashboard_xy_chart" "test" {
+  title = "Test"
+}
+`
+
+const testAccXYChartDataSourceProviderCustomDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "xychart",
+  "options": {
+    "seriesMapping": "auto",
+    "dims": {
+      "frame": 0,
+      "x": "throughput"
+    },
+    "series": [
+      {
+        "pointColor": {},
+        "pointSize": {
+          "fixed": 3,
+          "min": 1,
+          "max": 20
+        }
+      }
+    ],
+    "legend": {
+      "calcs": [],
+      "displayMode": "hidden",
+      "placement": "bottom",
+      "showLegend": false
+    },
+    "tooltip": {
+      "mode": "hidden"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "short",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 3,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "show": "lines",
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "log",
+          "log": 2
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccXYChartDataSourceProviderDefaultsConfig = `
+data "gdashboard_xy_chart" "test" {
+  title = "Test"
+}
+`
+
+const testAccXYChartDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "xychart",
+  "options": {
+    "seriesMapping": "auto",
+    "dims": {
+      "frame": 0
+    },
+    "series": [
+      {
+        "pointColor": {},
+        "pointSize": {
+          "fixed": 5,
+          "min": 1,
+          "max": 20
+        }
+      }
+    ],
+    "legend": {
+      "calcs": [],
+      "displayMode": "list",
+      "placement": "bottom",
+      "showLegend": true
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 1,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "show": "points",
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`